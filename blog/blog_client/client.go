@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/minhtran241/grpc-go/blog/blogpb"
 )
@@ -40,6 +41,9 @@ func main() {
 
 	c := blogpb.NewBlogServiceClient(cc)
 
+	// the server records this name in its audit log as a claim, next to the verified actor
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-actor", "blog_client")
+
 	// create Blog
 	fmt.Println("Creating the blog...")
 	blog := &blogpb.Blog{
@@ -47,7 +51,7 @@ func main() {
 		Title:    "Big Data",
 		Content:  "An introduction to Big Data",
 	}
-	createBlogRes, err := c.CreateBlog(context.Background(), &blogpb.CreateBlogRequest{Blog: blog})
+	createBlogRes, err := c.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: blog})
 	if err != nil {
 		log.Fatalf("Unexpected error: %v", err)
 	}
@@ -77,7 +81,7 @@ func main() {
 		Title:    "Big Data (edited)",
 		Content:  "An introduction to Big Data (edited)",
 	}
-	updateRes, updateErr := c.UpdateBlog(context.Background(), &blogpb.UpdateBlogRequest{
+	updateRes, updateErr := c.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{
 		Blog: newBlog,
 	})
 	if updateErr != nil {
@@ -86,7 +90,7 @@ func main() {
 	fmt.Printf("Blog was updated: %v\n", updateRes)
 
 	// delete Blog
-	deleteRes, deleteErr := c.DeleteBlog(context.Background(), &blogpb.DeleteBlogRequest{
+	deleteRes, deleteErr := c.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{
 		BlogId: blogId,
 	})
 	if deleteErr != nil {
@@ -109,4 +113,23 @@ func main() {
 		}
 		fmt.Println(res.GetBlog())
 	}
+
+	// list the audit trail of the blog we just played with
+	auditStream, err := c.ListAuditEvents(context.Background(), &blogpb.ListAuditEventsRequest{
+		BlogId: blogId,
+	})
+	if err != nil {
+		log.Fatalf("Error while calling ListAuditEvents RPC: %v", err)
+	}
+	for {
+		res, err := auditStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("Something went wrong: %v\n", err)
+		}
+		event := res.GetEvent()
+		fmt.Printf("%v %v by %v (claims %q) from %v: %v -> %v\n", event.GetTime().AsTime(), event.GetRpc(), event.GetActor(), event.GetClaimedActor(), event.GetPeer(), event.GetBefore(), event.GetAfter())
+	}
 }
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/minhtran241/grpc-go/blog/blogpb"
+)
+
+// actorMetadataKey is the request metadata naming who claims to make a change.
+// Clients can send any name, so it is recorded as a claim next to the actor.
+const actorMetadataKey = "x-actor"
+
+// anonymousActor is the actor of changes made without a verified client certificate
+const anonymousActor = "anonymous"
+
+// audit records every blog mutation
+var audit auditLog
+
+type auditEvent struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`
+	Actor  string             `bson:"actor"`
+	Peer   string             `bson:"peer"`
+	Claim  string             `bson:"claimed_actor,omitempty"`
+	RPC    string             `bson:"rpc"`
+	BlogID string             `bson:"blog_id"`
+	Before *blogItem          `bson:"before,omitempty"`
+	After  *blogItem          `bson:"after,omitempty"`
+	Time   time.Time          `bson:"time"`
+}
+
+// auditLog is an append-only store of audit events.
+// There is deliberately no way to change or remove an event once appended.
+type auditLog interface {
+	Append(ctx context.Context, event *auditEvent) error
+	// List calls fn with the events in [from, to) in time order, only those of
+	// blogID when it is not empty. A zero from or to leaves that side open.
+	List(ctx context.Context, from, to time.Time, blogID string, fn func(*auditEvent) error) error
+}
+
+// recordAudit appends an event for a mutation that already succeeded.
+// The append is detached from the RPC context so that a client hanging up
+// right after its change went through cannot keep the change out of the log.
+// A failed append cannot undo the change, so it is logged rather than returned.
+func recordAudit(ctx context.Context, rpc string, blogID string, before, after *blogItem) {
+	actor, peerAddr, claim := auditIdentity(ctx)
+	event := &auditEvent{
+		Actor:  actor,
+		Peer:   peerAddr,
+		Claim:  claim,
+		RPC:    rpc,
+		BlogID: blogID,
+		Before: before,
+		After:  after,
+		Time:   time.Now().UTC(),
+	}
+
+	appendCtx, cancel := storageContext(context.Background())
+	defer cancel()
+	if err := audit.Append(appendCtx, event); err != nil {
+		log.Printf("AUDIT LOSS: cannot record %v of blog %v by %v at %v: %v", rpc, blogID, event.Actor, event.Peer, err)
+	}
+}
+
+// auditIdentity tells who makes a change. The actor is the common name of the
+// client certificate the TLS handshake verified, or anonymousActor without one.
+// The peer address is always known to the server; the x-actor metadata is
+// only what the client claims to be.
+func auditIdentity(ctx context.Context) (actor, peerAddr, claim string) {
+	actor, peerAddr = anonymousActor, "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		if p.Addr != nil {
+			peerAddr = p.Addr.String()
+		}
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if chains := tlsInfo.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+				if cn := chains[0][0].Subject.CommonName; cn != "" {
+					actor = cn
+				}
+			}
+		}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if claims := md.Get(actorMetadataKey); len(claims) > 0 {
+			claim = claims[0]
+		}
+	}
+	return actor, peerAddr, claim
+}
+
+// serverTLSConfig serves certFile and verifies the client certificates signed
+// by caFile. A certificate is not required, clients without one are anonymous.
+func serverTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate found in %v", caFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    clientCAs,
+	}, nil
+}
+
+func auditEventToPb(event *auditEvent) *blogpb.AuditEvent {
+	res := &blogpb.AuditEvent{
+		Id:           event.ID.Hex(),
+		Actor:        event.Actor,
+		Peer:         event.Peer,
+		ClaimedActor: event.Claim,
+		Rpc:          event.RPC,
+		BlogId:       event.BlogID,
+		Time:         timestamppb.New(event.Time),
+	}
+	if event.Before != nil {
+		res.Before = dataToBlogPb(event.Before)
+	}
+	if event.After != nil {
+		res.After = dataToBlogPb(event.After)
+	}
+	return res
+}
+
+func (*server) ListAuditEvents(in *blogpb.ListAuditEventsRequest, stream blogpb.BlogService_ListAuditEventsServer) error {
+	fmt.Println("List audit events request")
+	ctx := stream.Context()
+
+	var from, to time.Time
+	if in.GetStartTime() != nil {
+		if err := in.GetStartTime().CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid start_time: %v", err))
+		}
+		from = in.GetStartTime().AsTime()
+	}
+	if in.GetEndTime() != nil {
+		if err := in.GetEndTime().CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid end_time: %v", err))
+		}
+		to = in.GetEndTime().AsTime()
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return status.Errorf(codes.InvalidArgument, fmt.Sprintf("end_time %v is before start_time %v", to, from))
+	}
+
+	var sendErr error
+	err := audit.List(ctx, from, to, in.GetBlogId(), func(event *auditEvent) error {
+		sendErr = stream.Send(&blogpb.ListAuditEventsResponse{
+			Event: auditEventToPb(event),
+		})
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return storageError(ctx, err, "Can not list audit events")
+	}
+	return nil
+}
+
+// mongoAuditLog keeps audit events in their own MongoDB collection, written with inserts only
+type mongoAuditLog struct {
+	coll *mongo.Collection
+}
+
+func (l *mongoAuditLog) Append(ctx context.Context, event *auditEvent) error {
+	_, err := l.coll.InsertOne(ctx, event)
+	return err
+}
+
+func (l *mongoAuditLog) List(ctx context.Context, from, to time.Time, blogID string, fn func(*auditEvent) error) error {
+	filter := bson.D{}
+	timeRange := bson.D{}
+	if !from.IsZero() {
+		timeRange = append(timeRange, bson.E{Key: "$gte", Value: from})
+	}
+	if !to.IsZero() {
+		timeRange = append(timeRange, bson.E{Key: "$lt", Value: to})
+	}
+	if len(timeRange) > 0 {
+		filter = append(filter, bson.E{Key: "time", Value: timeRange})
+	}
+	if blogID != "" {
+		filter = append(filter, bson.E{Key: "blog_id", Value: blogID})
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "time", Value: 1}, {Key: "_id", Value: 1}})
+
+	findCtx, cancelFind := storageContext(ctx)
+	defer cancelFind()
+	cur, err := l.coll.Find(findCtx, filter, opts)
+	if err != nil {
+		if findCtx.Err() != nil {
+			return findCtx.Err()
+		}
+		return err
+	}
+	defer cur.Close(context.Background())
+
+	for {
+		nextCtx, cancelNext := storageContext(ctx)
+		more := cur.Next(nextCtx)
+		cancelNext()
+		if !more {
+			if nextCtx.Err() != nil {
+				return nextCtx.Err()
+			}
+			return cur.Err()
+		}
+
+		event := &auditEvent{}
+		if err := cur.Decode(event); err != nil {
+			return err
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+}
+
+// memoryAuditLog keeps audit events in process memory, used with the memory store
+type memoryAuditLog struct {
+	mu     sync.RWMutex
+	events []*auditEvent // in append order
+}
+
+func (l *memoryAuditLog) Append(ctx context.Context, event *auditEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	stored := *event
+	stored.ID = primitive.NewObjectID()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, &stored)
+	return nil
+}
+
+func (l *memoryAuditLog) List(ctx context.Context, from, to time.Time, blogID string, fn func(*auditEvent) error) error {
+	// events are never modified, so a copy of the slice is a consistent snapshot
+	l.mu.RLock()
+	events := l.events[:len(l.events):len(l.events)]
+	l.mu.RUnlock()
+
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !from.IsZero() && event.Time.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !event.Time.Before(to) {
+			continue
+		}
+		if blogID != "" && event.BlogID != blogID {
+			continue
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
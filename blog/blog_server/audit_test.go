@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/minhtran241/grpc-go/blog/blogpb"
+)
+
+// tlsPeer is a client at 10.0.0.1 that presented a certificate for commonName,
+// verified by the handshake when verified is set
+func tlsPeer(commonName string, verified bool) *peer.Peer {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4000},
+		AuthInfo: credentials.TLSInfo{State: state},
+	}
+}
+
+func TestAuditIdentity(t *testing.T) {
+	claiming := func(ctx context.Context) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(actorMetadataKey, "mallory"))
+	}
+	tests := []struct {
+		name                   string
+		ctx                    context.Context
+		actor, peerAddr, claim string
+	}{
+		{"no peer", context.Background(), anonymousActor, "unknown", ""},
+		{"verified certificate", peer.NewContext(context.Background(), tlsPeer("ann", true)), "ann", "10.0.0.1:4000", ""},
+		{"unverified certificate", peer.NewContext(context.Background(), tlsPeer("ann", false)), anonymousActor, "10.0.0.1:4000", ""},
+		{"claim only", claiming(context.Background()), anonymousActor, "unknown", "mallory"},
+		{"claim and certificate", claiming(peer.NewContext(context.Background(), tlsPeer("ann", true))), "ann", "10.0.0.1:4000", "mallory"},
+	}
+	for _, tt := range tests {
+		actor, peerAddr, claim := auditIdentity(tt.ctx)
+		if actor != tt.actor || peerAddr != tt.peerAddr || claim != tt.claim {
+			t.Errorf("%v: auditIdentity = %q, %q, %q, want %q, %q, %q", tt.name, actor, peerAddr, claim, tt.actor, tt.peerAddr, tt.claim)
+		}
+	}
+}
+
+// auditStream collects what ListAuditEvents sends
+type auditStream struct {
+	grpc.ServerStream
+	sent []*blogpb.AuditEvent
+}
+
+func (s *auditStream) Context() context.Context { return context.Background() }
+
+func (s *auditStream) Send(res *blogpb.ListAuditEventsResponse) error {
+	s.sent = append(s.sent, res.GetEvent())
+	return nil
+}
+
+func TestAuditMutations(t *testing.T) {
+	useMemoryStore(t)
+	ctx := peer.NewContext(context.Background(), tlsPeer("ann", true))
+	s := &server{}
+
+	created, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{Title: "One"}})
+	if err != nil {
+		t.Fatalf("CreateBlog failed: %v", err)
+	}
+	id := created.GetBlog().GetId()
+	if _, err := s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: &blogpb.Blog{Id: id, Title: "Two"}}); err != nil {
+		t.Fatalf("UpdateBlog failed: %v", err)
+	}
+	if _, err := s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: id}); err != nil {
+		t.Fatalf("DeleteBlog failed: %v", err)
+	}
+	// failed mutations are not audited
+	if _, err := s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("second DeleteBlog: got %v, want code %v", err, codes.NotFound)
+	}
+
+	stream := &auditStream{}
+	if err := s.ListAuditEvents(&blogpb.ListAuditEventsRequest{BlogId: id}, stream); err != nil {
+		t.Fatalf("ListAuditEvents failed: %v", err)
+	}
+	tests := []struct {
+		rpc           string
+		before, after string // titles, empty when there is no blog
+	}{
+		{"CreateBlog", "", "One"},
+		{"UpdateBlog", "One", "Two"},
+		{"DeleteBlog", "Two", ""},
+	}
+	if len(stream.sent) != len(tests) {
+		t.Fatalf("ListAuditEvents sent %d events, want %d", len(stream.sent), len(tests))
+	}
+	for i, tt := range tests {
+		event := stream.sent[i]
+		if event.GetRpc() != tt.rpc || event.GetBefore().GetTitle() != tt.before || event.GetAfter().GetTitle() != tt.after {
+			t.Errorf("event %d = %v, want %v from %q to %q", i, event, tt.rpc, tt.before, tt.after)
+		}
+		if event.GetActor() != "ann" || event.GetPeer() != "10.0.0.1:4000" || event.GetBlogId() != id {
+			t.Errorf("event %d = %v, want ann at 10.0.0.1:4000 changing blog %v", i, event, id)
+		}
+	}
+}
+
+func TestMemoryAuditLogList(t *testing.T) {
+	l := &memoryAuditLog{}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, blogID := range []string{"a", "b", "a", "b"} {
+		event := &auditEvent{RPC: "UpdateBlog", BlogID: blogID, Time: start.Add(time.Duration(i) * time.Hour)}
+		if err := l.Append(context.Background(), event); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		from, to time.Time
+		blogID   string
+		want     []int // hours after start of the events listed
+	}{
+		{want: []int{0, 1, 2, 3}},
+		{blogID: "a", want: []int{0, 2}},
+		{from: start.Add(time.Hour), to: start.Add(3 * time.Hour), want: []int{1, 2}},
+		{from: start.Add(time.Hour), blogID: "b", want: []int{1, 3}},
+		{to: start, want: nil},
+	}
+	for _, tt := range tests {
+		var got []int
+		err := l.List(context.Background(), tt.from, tt.to, tt.blogID, func(event *auditEvent) error {
+			got = append(got, int(event.Time.Sub(start)/time.Hour))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("List(%v, %v, %q) = %v, want %v", tt.from, tt.to, tt.blogID, got, tt.want)
+		}
+	}
+}
+
+func TestListAuditEventsInvalidRange(t *testing.T) {
+	useMemoryStore(t)
+	in := &blogpb.ListAuditEventsRequest{
+		StartTime: timestamppb.New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	if err := (&server{}).ListAuditEvents(in, &auditStream{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListAuditEvents with end_time before start_time: got %v, want code %v", err, codes.InvalidArgument)
+	}
+}
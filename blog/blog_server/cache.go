@@ -0,0 +1,176 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// blogCache is a read-through LRU cache for blog documents.
+// Entries expire after ttl and the cache never holds more than maxBytes.
+// Concurrent misses for the same ID share a single backend fetch.
+type blogCache struct {
+	maxBytes int64
+	ttl      time.Duration
+
+	mu    sync.Mutex
+	size  int64
+	ll    *list.List               // front is most recently used
+	items map[string]*list.Element // blog ID -> element holding *cacheEntry
+	calls map[string]*cacheCall    // in-flight fetches by blog ID
+
+	hits   int64
+	misses int64
+}
+
+type cacheEntry struct {
+	key     string
+	item    *blogItem
+	size    int64
+	expires time.Time
+}
+
+// cacheCall is a fetch shared by every caller that missed on the same ID.
+type cacheCall struct {
+	done  chan struct{}
+	item  *blogItem
+	err   error
+	stale bool // set when the ID is invalidated while the fetch is running
+}
+
+// cacheStats is the snapshot exposed through expvar.
+type cacheStats struct {
+	Hits     int64 `json:"hits"`
+	Misses   int64 `json:"misses"`
+	Entries  int   `json:"entries"`
+	Bytes    int64 `json:"bytes"`
+	MaxBytes int64 `json:"max_bytes"`
+}
+
+func newBlogCache(maxBytes int64, ttl time.Duration) *blogCache {
+	return &blogCache{
+		maxBytes: maxBytes,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+		calls:    make(map[string]*cacheCall),
+	}
+}
+
+// Get returns the cached blog for key, or calls fetch to load it.
+// The returned item is shared with the cache and must not be modified.
+// Callers waiting on another caller's fetch give up when ctx is done.
+func (c *blogCache) Get(ctx context.Context, key string, fetch func(context.Context) (*blogItem, error)) (*blogItem, error) {
+	c.mu.Lock()
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*cacheEntry)
+		if time.Now().Before(entry.expires) {
+			c.ll.MoveToFront(el)
+			c.mu.Unlock()
+			atomic.AddInt64(&c.hits, 1)
+			return entry.item, nil
+		}
+		c.removeElement(el)
+	}
+	atomic.AddInt64(&c.misses, 1)
+
+	if call, ok := c.calls[key]; ok {
+		// someone is already fetching this ID, wait for their result
+		c.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if isContextError(call.err) && ctx.Err() == nil {
+			// the fetch died with the other caller's context, not ours
+			return c.Get(ctx, key, fetch)
+		}
+		return call.item, call.err
+	}
+	call := &cacheCall{done: make(chan struct{})}
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	call.item, call.err = fetch(ctx)
+
+	c.mu.Lock()
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	if call.err == nil && !call.stale {
+		c.add(key, call.item)
+	}
+	c.mu.Unlock()
+	close(call.done)
+
+	return call.item, call.err
+}
+
+// Invalidate drops key from the cache. A fetch already running for key
+// still answers its callers but its result is not stored.
+func (c *blogCache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+	if call, ok := c.calls[key]; ok {
+		call.stale = true
+		delete(c.calls, key)
+	}
+}
+
+func (c *blogCache) Stats() cacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return cacheStats{
+		Hits:     atomic.LoadInt64(&c.hits),
+		Misses:   atomic.LoadInt64(&c.misses),
+		Entries:  c.ll.Len(),
+		Bytes:    c.size,
+		MaxBytes: c.maxBytes,
+	}
+}
+
+// add stores item under key and evicts least recently used entries
+// until the cache fits in maxBytes. Callers must hold c.mu.
+func (c *blogCache) add(key string, item *blogItem) {
+	size := itemSize(item)
+	if size > c.maxBytes {
+		return
+	}
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+	entry := &cacheEntry{
+		key:     key,
+		item:    item,
+		size:    size,
+		expires: time.Now().Add(c.ttl),
+	}
+	c.items[key] = c.ll.PushFront(entry)
+	c.size += size
+
+	for c.size > c.maxBytes {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// removeElement unlinks el from the cache. Callers must hold c.mu.
+func (c *blogCache) removeElement(el *list.Element) {
+	entry := c.ll.Remove(el).(*cacheEntry)
+	delete(c.items, entry.key)
+	c.size -= entry.size
+}
+
+// itemSize approximates the memory held by a cached blog: the string
+// payloads plus a fixed allowance for the entry and list bookkeeping.
+func itemSize(item *blogItem) int64 {
+	const overhead = 128
+	return int64(overhead + len(item.ID) + len(item.AuthorID) + len(item.Title) + len(item.Content))
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fetchItem is a fetch for blogCache.Get that counts its calls
+func fetchItem(calls *int32, title string) func(context.Context) (*blogItem, error) {
+	return func(context.Context) (*blogItem, error) {
+		atomic.AddInt32(calls, 1)
+		return &blogItem{Title: title}, nil
+	}
+}
+
+func TestBlogCache(t *testing.T) {
+	c := newBlogCache(1<<20, time.Minute)
+	var calls int32
+	for i := 0; i < 3; i++ {
+		item, err := c.Get(context.Background(), "a", fetchItem(&calls, "first"))
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		if item.Title != "first" {
+			t.Errorf("Get = %q, want %q", item.Title, "first")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("the blog was fetched %d times, want once", calls)
+	}
+	if stats := c.Stats(); stats.Hits != 2 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Errorf("Stats = %+v, want 2 hits, 1 miss and 1 entry", stats)
+	}
+
+	c.Invalidate("a")
+	item, err := c.Get(context.Background(), "a", fetchItem(&calls, "second"))
+	if err != nil || item.Title != "second" || calls != 2 {
+		t.Errorf("Get after Invalidate = %v, %v after %d fetches, want the second title after 2", item, err, calls)
+	}
+
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := c.Get(context.Background(), "b", func(context.Context) (*blogItem, error) {
+			return nil, errNotFound
+		})
+		if err != errNotFound {
+			t.Errorf("Get = %v, want %v", err, errNotFound)
+		}
+	}
+	if stats := c.Stats(); stats.Entries != 1 {
+		t.Errorf("Stats = %+v, want only the blog that was found", stats)
+	}
+}
+
+func TestBlogCacheExpiry(t *testing.T) {
+	c := newBlogCache(1<<20, 0)
+	var calls int32
+	for i := 0; i < 2; i++ {
+		if _, err := c.Get(context.Background(), "a", fetchItem(&calls, "a")); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("an expired blog was fetched %d times, want twice", calls)
+	}
+}
+
+func TestBlogCacheEviction(t *testing.T) {
+	size := itemSize(&blogItem{Title: "0"})
+	c := newBlogCache(3*size, time.Minute)
+	var calls int32
+	for i := 0; i < 4; i++ {
+		if _, err := c.Get(context.Background(), fmt.Sprint(i), fetchItem(&calls, fmt.Sprint(i))); err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+	}
+	if stats := c.Stats(); stats.Entries != 3 || stats.Bytes != 3*size {
+		t.Errorf("Stats = %+v, want 3 entries of %d bytes", stats, size)
+	}
+
+	// the least recently used blog is gone, the others are kept
+	before := calls
+	c.Get(context.Background(), "3", fetchItem(&calls, "3"))
+	c.Get(context.Background(), "1", fetchItem(&calls, "1"))
+	if calls != before {
+		t.Errorf("a recent blog was fetched again")
+	}
+	c.Get(context.Background(), "0", fetchItem(&calls, "0"))
+	if calls != before+1 {
+		t.Errorf("the least recently used blog was not evicted")
+	}
+
+	// a blog larger than the whole cache is not stored
+	c.Get(context.Background(), "big", fetchItem(&calls, strings.Repeat("x", int(3*size))))
+	if _, ok := c.items["big"]; ok {
+		t.Errorf("a blog larger than the cache was stored")
+	}
+}
+
+func TestBlogCacheSharedFetch(t *testing.T) {
+	c := newBlogCache(1<<20, time.Minute)
+	var calls int32
+	release := make(chan struct{})
+	slow := func(context.Context) (*blogItem, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return &blogItem{Title: "slow"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			item, err := c.Get(context.Background(), "a", slow)
+			if err != nil || item.Title != "slow" {
+				t.Errorf("Get = %v, %v, want the slow blog", item, err)
+			}
+		}()
+	}
+	// let every caller miss before the fetch completes
+	for c.Stats().Misses < 10 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("the blog was fetched %d times, want once", calls)
+	}
+}
+
+func TestBlogCacheInvalidateDuringFetch(t *testing.T) {
+	c := newBlogCache(1<<20, time.Minute)
+	var calls int32
+	_, err := c.Get(context.Background(), "a", func(ctx context.Context) (*blogItem, error) {
+		// an update lands while the old version is being read
+		c.Invalidate("a")
+		return fetchItem(&calls, "old")(ctx)
+	})
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	item, err := c.Get(context.Background(), "a", fetchItem(&calls, "new"))
+	if err != nil || item.Title != "new" {
+		t.Errorf("Get = %v, %v, want the blog fetched after the update", item, err)
+	}
+}
+
+func TestBlogCacheFetcherGivesUp(t *testing.T) {
+	c := newBlogCache(1<<20, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := c.Get(ctx, "a", func(ctx context.Context) (*blogItem, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		done <- err
+	}()
+	<-started
+
+	// a caller waiting on a fetch that died with another caller's context fetches again
+	var calls int32
+	waiting := make(chan *blogItem)
+	go func() {
+		item, _ := c.Get(context.Background(), "a", fetchItem(&calls, "a"))
+		waiting <- item
+	}()
+	for c.Stats().Misses < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("the cancelled fetch returned %v, want %v", err, context.Canceled)
+	}
+	if item := <-waiting; item == nil || item.Title != "a" || calls != 1 {
+		t.Errorf("the waiting caller got %v after %d fetches, want the blog after one", item, calls)
+	}
+}
+
+func TestStartDebugServer(t *testing.T) {
+	lis, err := startDebugServer("localhost:0")
+	if err != nil {
+		t.Fatalf("startDebugServer failed: %v", err)
+	}
+	defer lis.Close()
+
+	res, err := http.Get("http://" + lis.Addr().String() + "/debug/vars")
+	if err != nil {
+		t.Fatalf("GET /debug/vars failed: %v", err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("cannot read /debug/vars: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || !strings.Contains(string(body), `"memstats"`) {
+		t.Errorf("GET /debug/vars = %v %.100s, want the expvar counters", res.Status, body)
+	}
+
+	// the address is taken now
+	if other, err := startDebugServer(lis.Addr().String()); err == nil {
+		other.Close()
+		t.Errorf("startDebugServer on a used address succeeded")
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/minhtran241/grpc-go/blog/blogpb"
+	"github.com/minhtran241/grpc-go/blog/config"
+)
+
+// ensureIndexes creates the indexes the blog and audit queries rely on.
+// Creating an index that already exists is a no-op in MongoDB.
+func ensureIndexes(ctx context.Context, blogs, auditEvents *mongo.Collection) error {
+	_, err := blogs.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "author_id", Value: 1}},
+			Options: options.Index().SetName("author_id_1"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create indexes on %v: %v", blogs.Name(), err)
+	}
+
+	_, err = auditEvents.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "time", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("time_1__id_1"),
+		},
+		{
+			Keys:    bson.D{{Key: "blog_id", Value: 1}, {Key: "time", Value: 1}},
+			Options: options.Index().SetName("blog_id_1_time_1"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot create indexes on %v: %v", auditEvents.Name(), err)
+	}
+	return nil
+}
+
+// watchMongo pings MongoDB every interval until ctx is done and reports the
+// blog service as NOT_SERVING while the database cannot be reached.
+func watchMongo(ctx context.Context, healthServer *health.Server, client *mongo.Client, interval, timeout time.Duration) {
+	service := blogpb.BlogService_ServiceDesc.ServiceName
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	reachable := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := config.PingMongo(client, timeout)
+		if (err == nil) == reachable {
+			continue
+		}
+		reachable = err == nil
+		if reachable {
+			log.Printf("MongoDB is reachable again")
+			healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+		} else {
+			log.Printf("MongoDB is not reachable: %v", err)
+			healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/minhtran241/grpc-go/blog/blogpb"
+)
+
+func TestWatchMongoUnreachable(t *testing.T) {
+	// nothing listens on port 1, and the driver only dials when pinged
+	client, err := mongo.Connect(context.Background(), options.Client().
+		ApplyURI("mongodb://127.0.0.1:1").
+		SetServerSelectionTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatalf("cannot create the client: %v", err)
+	}
+	defer client.Disconnect(context.Background())
+
+	service := blogpb.BlogService_ServiceDesc.ServiceName
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watchMongo(ctx, healthServer, client, 10*time.Millisecond, 50*time.Millisecond)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		res, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check failed: %v", err)
+		}
+		if res.GetStatus() == healthpb.HealthCheckResponse_NOT_SERVING {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the blog service is still %v while MongoDB is unreachable", res.GetStatus())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -2,60 +2,97 @@ package main
 
 import (
 	"context"
+	"errors"
+	"expvar"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"time"
 
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
 	"github.com/minhtran241/grpc-go/blog/blogpb"
+	"github.com/minhtran241/grpc-go/blog/config"
+	"github.com/minhtran241/grpc-go/blog/migrations"
 )
 
-var collection *mongo.Collection
+// store holds the blogs, in MongoDB unless -store=memory is given
+var store blogStore
+
+// cache sits in front of ReadBlog; UpdateBlog and DeleteBlog invalidate it
+var cache *blogCache
+
+// opTimeout caps a single MongoDB operation, even when the RPC deadline is longer
+var opTimeout time.Duration
 
 type server struct{}
 
 type blogItem struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty"`
-	AuthorID string             `bson:"author_id"`
-	Content  string             `bson:"content"`
-	Title    string             `bson:"title"`
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	AuthorID  string             `bson:"author_id"`
+	Content   string             `bson:"content"`
+	Title     string             `bson:"title"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// storageContext derives the context for one storage call from the RPC context
+func storageContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, opTimeout)
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
+
+// storageError converts an error from a storage call made with ctx into a gRPC status.
+// The driver does not always wrap context errors, so ctx itself is checked as well.
+func storageError(ctx context.Context, err error, msg string) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && !isContextError(err) {
+		err = ctxErr
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, fmt.Sprintf("%v: %v", msg, err))
+	case errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, fmt.Sprintf("%v: %v", msg, err))
+	default:
+		return status.Errorf(codes.Internal, fmt.Sprintf("%v: %v", msg, err))
+	}
 }
 
 func (*server) CreateBlog(ctx context.Context, in *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
 	fmt.Println("Create blog request")
 	blog := in.GetBlog()
-	data := blogItem{
-		AuthorID: blog.GetAuthorId(),
-		Title:    blog.GetTitle(),
-		Content:  blog.GetContent(),
+	now := time.Now().UTC()
+	data := &blogItem{
+		AuthorID:  blog.GetAuthorId(),
+		Title:     blog.GetTitle(),
+		Content:   blog.GetContent(),
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
-	res, err := collection.InsertOne(context.Background(), data)
+	opCtx, cancel := storageContext(ctx)
+	defer cancel()
+	oid, err := store.Insert(opCtx, data)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", err),
-		)
-	}
-
-	oid, ok := res.InsertedID.(primitive.ObjectID)
-	if !ok {
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintln("Cannot convert to OID"),
-		)
+		return nil, storageError(opCtx, err, "Internal error")
 	}
+	data.ID = oid
+	recordAudit(ctx, "CreateBlog", oid.Hex(), nil, data)
 
 	return &blogpb.CreateBlogResponse{
 		Blog: &blogpb.Blog{
@@ -86,15 +123,16 @@ func (*server) ReadBlog(ctx context.Context, in *blogpb.ReadBlogRequest) (*blogp
 			fmt.Sprintln("Can not parse ID"),
 		)
 	}
-	// create an empty struct
-	data := &blogItem{}
-	filter := bson.D{{"_id", oid}}
-	err = collection.FindOne(context.Background(), filter).Decode(data)
-	if err == mongo.ErrNoDocuments {
+	opCtx, cancel := storageContext(ctx)
+	defer cancel()
+	data, err := cache.Get(opCtx, oid.Hex(), func(ctx context.Context) (*blogItem, error) {
+		return store.Find(ctx, oid)
+	})
+	if err == errNotFound {
 		// Do something when no record was found
-		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", err))
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", blogID))
 	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Something went wrong: %v\n", err))
+		return nil, storageError(opCtx, err, "Something went wrong")
 	}
 	return &blogpb.ReadBlogResponse{
 		Blog: dataToBlogPb(data),
@@ -111,24 +149,33 @@ func (*server) UpdateBlog(ctx context.Context, in *blogpb.UpdateBlogRequest) (*b
 			fmt.Sprintln("Can not parse ID"),
 		)
 	}
-	data := &blogItem{}
-	filter := bson.D{{"_id", oid}}
-	err = collection.FindOne(context.Background(), filter).Decode(data)
-	if err == mongo.ErrNoDocuments {
+	findCtx, cancelFind := storageContext(ctx)
+	defer cancelFind()
+	data, err := store.Find(findCtx, oid)
+	if err == errNotFound {
 		// Do something when no record was found
-		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", err))
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", blog.GetId()))
 	} else if err != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Something went wrong: %v\n", err))
+		return nil, storageError(findCtx, err, "Something went wrong")
 	}
+	before := *data
 	// we update our internal struct
 	data.AuthorID = blog.GetAuthorId()
 	data.Content = blog.GetContent()
 	data.Title = blog.GetTitle()
+	data.UpdatedAt = time.Now().UTC()
 
-	_, updateErr := collection.ReplaceOne(context.Background(), filter, data)
-	if updateErr != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Can not update object in MongoDB: %v\n", updateErr))
+	replaceCtx, cancelReplace := storageContext(ctx)
+	defer cancelReplace()
+	updateErr := store.Replace(replaceCtx, data)
+	if updateErr == errNotFound {
+		// deleted while we were updating it
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", blog.GetId()))
+	} else if updateErr != nil {
+		return nil, storageError(replaceCtx, updateErr, "Can not update object in MongoDB")
 	}
+	cache.Invalidate(oid.Hex())
+	recordAudit(ctx, "UpdateBlog", oid.Hex(), &before, data)
 	return &blogpb.UpdateBlogResponse{
 		Blog: dataToBlogPb(data),
 	}, nil
@@ -144,14 +191,17 @@ func (*server) DeleteBlog(ctx context.Context, in *blogpb.DeleteBlogRequest) (*b
 			fmt.Sprintln("Can not parse ID"),
 		)
 	}
-	filter := bson.D{{"_id", oid}}
-	deleteRes, deleteErr := collection.DeleteOne(context.Background(), filter)
-	if deleteRes.DeletedCount == 0 {
+	opCtx, cancel := storageContext(ctx)
+	defer cancel()
+	deleted, deleteErr := store.Delete(opCtx, oid)
+	if deleteErr == errNotFound {
 		// Do something when no record was found
-		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", deleteErr))
+		return nil, status.Errorf(codes.NotFound, fmt.Sprintf("Can not find blog with specified ID: %v\n", blogID))
 	} else if deleteErr != nil {
-		return nil, status.Errorf(codes.Internal, fmt.Sprintf("Something went wrong: %v\n", deleteErr))
+		return nil, storageError(opCtx, deleteErr, "Something went wrong")
 	}
+	cache.Invalidate(oid.Hex())
+	recordAudit(ctx, "DeleteBlog", oid.Hex(), deleted, nil)
 	return &blogpb.DeleteBlogResponse{
 		BlogId: blogID,
 	}, nil
@@ -159,50 +209,95 @@ func (*server) DeleteBlog(ctx context.Context, in *blogpb.DeleteBlogRequest) (*b
 
 func (*server) ListBlog(in *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
 	fmt.Println("List blog request")
-	// cursor
-	cur, err := collection.Find(context.Background(), bson.D{})
-	if err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Internal error: %v\n", err))
-	}
-	defer cur.Close(context.Background())
+	ctx := stream.Context()
 
-	for cur.Next(context.Background()) {
-		data := &blogItem{}
-		err := cur.Decode(data)
-		if err != nil {
-			return status.Errorf(codes.Internal, fmt.Sprintf("Error while decoding data from MongoDB: %v\n", err))
-		}
-		stream.Send(&blogpb.ListBlogResponse{
+	var sendErr error
+	err := store.List(ctx, func(data *blogItem) error {
+		sendErr = stream.Send(&blogpb.ListBlogResponse{
 			Blog: dataToBlogPb(data),
 		})
+		return sendErr
+	})
+	if sendErr != nil {
+		return sendErr
 	}
-	if err := cur.Err(); err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Internal error: %v\n", err))
+	if err != nil {
+		return storageError(ctx, err, "Internal error")
 	}
 	return nil
 }
 
+// startDebugServer serves the expvar counters at /debug/vars on addr until
+// the returned listener is closed
+func startDebugServer(addr string) (net.Listener, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		if err := http.Serve(lis, nil); err != nil {
+			log.Printf("Debug server stopped: %v", err)
+		}
+	}()
+	return lis, nil
+}
+
 func main() {
 	// if we crash the go code, we get the file name and line number
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
-	fmt.Println("Connecting to MongoDB...")
-	// connect to MongoDB
-	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	cfg, err := config.Load(flag.CommandLine, os.Args[1:])
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("Invalid configuration: %v", err)
 	}
+	opTimeout = cfg.OpTimeout
+	cache = newBlogCache(cfg.CacheMaxBytes, cfg.CacheTTL)
 
-	defer func() {
-		fmt.Println("Closing MongoDB Connection...")
-		if err = client.Disconnect(context.TODO()); err != nil {
-			panic(err)
+	var client *mongo.Client // nil for the memory store
+	switch cfg.Store {
+	case "memory":
+		fmt.Println("Keeping blogs in memory...")
+		store = newMemoryStore()
+		audit = &memoryAuditLog{}
+	case "mongo":
+		fmt.Println("Connecting to MongoDB...")
+		// connect to MongoDB, this fails fast when the database is unreachable
+		client, err = config.ConnectMongo(cfg)
+		if err != nil {
+			log.Fatal(err)
 		}
-	}()
 
-	fmt.Println("Blog Server is running...")
-	collection = client.Database("mydb").Collection("blog")
+		defer func() {
+			fmt.Println("Closing MongoDB Connection...")
+			if err = client.Disconnect(context.TODO()); err != nil {
+				panic(err)
+			}
+		}()
+
+		collection := client.Database(cfg.Database).Collection(cfg.Collection)
+		auditCollection := client.Database(cfg.Database).Collection(cfg.AuditCollection)
 
+		indexCtx, cancelIndex := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+		err = ensureIndexes(indexCtx, collection, auditCollection)
+		cancelIndex()
+		if err != nil {
+			log.Fatal(err)
+		}
+		store = newMongoStore(collection)
+		audit = &mongoAuditLog{coll: auditCollection}
+	}
+
+	if cfg.MigrateOnStart {
+		fmt.Println("Running schema migrations...")
+		migrateCtx, cancelMigrate := context.WithTimeout(context.Background(), cfg.MigrateTimeout)
+		_, err := migrations.Run(migrateCtx, store, migrations.Blog, false, os.Stdout)
+		cancelMigrate()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	fmt.Println("Blog Server is running...")
 	lis, err := net.Listen("tcp", "localhost:50051") // port binding
 
 	if err != nil {
@@ -215,20 +310,41 @@ func main() {
 	if tls {
 		certFile := "ssl/server.crt"
 		keyFile := "ssl/server.pem"
-		creds, sslErr := credentials.NewServerTLSFromFile(certFile, keyFile)
+		caFile := "ssl/ca.crt" // client certificates signed by this CA name the actor in the audit log
+		tlsConfig, sslErr := serverTLSConfig(certFile, keyFile, caFile)
 		if sslErr != nil {
 			log.Fatalf("Failed loading certificates: %v", sslErr)
 			return
 		}
-		opts = append(opts, grpc.Creds(creds))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
 
 	s := grpc.NewServer(opts...)                   // grpc server
 	blogpb.RegisterBlogServiceServer(s, &server{}) // register greet service
 
+	// Report readiness through the standard gRPC health service
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(blogpb.BlogService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, healthServer)
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	if client != nil {
+		go watchMongo(watchCtx, healthServer, client, cfg.HealthCheckInterval, cfg.ConnectTimeout)
+	}
+
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
 
+	// Expose cache hit and miss counters at /debug/vars
+	if cfg.DebugAddr != "" {
+		expvar.Publish("blog_cache", expvar.Func(func() interface{} {
+			return cache.Stats()
+		}))
+		if _, err := startDebugServer(cfg.DebugAddr); err != nil {
+			log.Fatalf("Failed to listen for the debug server: %v", err)
+		}
+	}
+
 	go func() {
 		fmt.Println("Starting Server...")
 		if err := s.Serve(lis); err != nil { // bind the port to the grpc server
@@ -243,7 +359,10 @@ func main() {
 	// Block until a signal is received
 	<-ch
 	fmt.Println("Stopping the server...")
+	stopWatch()
+	healthServer.Shutdown()
 	s.Stop()
+	fmt.Printf("Cache stats: %+v\n", cache.Stats())
 	fmt.Println("Stopping the listener...")
 	lis.Close()
 	fmt.Println("End of Program")
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/blog/blogpb"
+)
+
+// useMemoryStore points the server at an empty memory store for the test
+func useMemoryStore(t *testing.T) {
+	t.Helper()
+	savedStore, savedCache, savedAudit, savedTimeout := store, cache, audit, opTimeout
+	store = newMemoryStore()
+	cache = newBlogCache(1<<20, time.Minute)
+	audit = &memoryAuditLog{}
+	opTimeout = time.Second
+	t.Cleanup(func() {
+		store, cache, audit, opTimeout = savedStore, savedCache, savedAudit, savedTimeout
+	})
+}
+
+func TestBlogRPCs(t *testing.T) {
+	useMemoryStore(t)
+	ctx := context.Background()
+	s := &server{}
+
+	created, err := s.CreateBlog(ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{AuthorId: "ann", Title: "One", Content: "first"}})
+	if err != nil {
+		t.Fatalf("CreateBlog failed: %v", err)
+	}
+	id := created.GetBlog().GetId()
+
+	read, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: id})
+	if err != nil || read.GetBlog().GetTitle() != "One" {
+		t.Fatalf("ReadBlog = %v, %v, want the created blog", read, err)
+	}
+
+	_, err = s.UpdateBlog(ctx, &blogpb.UpdateBlogRequest{Blog: &blogpb.Blog{Id: id, AuthorId: "ann", Title: "Two", Content: "second"}})
+	if err != nil {
+		t.Fatalf("UpdateBlog failed: %v", err)
+	}
+	// the update invalidated the cached blog
+	read, err = s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: id})
+	if err != nil || read.GetBlog().GetTitle() != "Two" {
+		t.Fatalf("ReadBlog after UpdateBlog = %v, %v, want the updated blog", read, err)
+	}
+
+	if _, err := s.DeleteBlog(ctx, &blogpb.DeleteBlogRequest{BlogId: id}); err != nil {
+		t.Fatalf("DeleteBlog failed: %v", err)
+	}
+	if _, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: id}); status.Code(err) != codes.NotFound {
+		t.Errorf("ReadBlog after DeleteBlog: got %v, want code %v", err, codes.NotFound)
+	}
+	if _, err := s.ReadBlog(ctx, &blogpb.ReadBlogRequest{BlogId: "nope"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReadBlog of an invalid ID: got %v, want code %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestBlogRPCsEndedContext(t *testing.T) {
+	useMemoryStore(t)
+	s := &server{}
+	created, err := s.CreateBlog(context.Background(), &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{Title: "One"}})
+	if err != nil {
+		t.Fatalf("CreateBlog failed: %v", err)
+	}
+	id := created.GetBlog().GetId()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	tests := []struct {
+		ctx  context.Context
+		code codes.Code
+	}{
+		{cancelled, codes.Canceled},
+		{expired, codes.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		if _, err := s.ReadBlog(tt.ctx, &blogpb.ReadBlogRequest{BlogId: id}); status.Code(err) != tt.code {
+			t.Errorf("ReadBlog: got %v, want code %v", err, tt.code)
+		}
+		if _, err := s.CreateBlog(tt.ctx, &blogpb.CreateBlogRequest{Blog: &blogpb.Blog{Title: "Two"}}); status.Code(err) != tt.code {
+			t.Errorf("CreateBlog: got %v, want code %v", err, tt.code)
+		}
+		if _, err := s.DeleteBlog(tt.ctx, &blogpb.DeleteBlogRequest{BlogId: id}); status.Code(err) != tt.code {
+			t.Errorf("DeleteBlog: got %v, want code %v", err, tt.code)
+		}
+	}
+}
+
+func TestStorageContext(t *testing.T) {
+	saved := opTimeout
+	opTimeout = time.Second
+	defer func() { opTimeout = saved }()
+
+	// opTimeout caps a longer RPC deadline
+	long, cancelLong := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelLong()
+	ctx, cancel := storageContext(long)
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Second {
+		t.Errorf("storage deadline is %v away, want at most %v", time.Until(deadline), time.Second)
+	}
+
+	// a shorter RPC deadline is kept
+	short, cancelShort := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelShort()
+	ctx, cancel = storageContext(short)
+	defer cancel()
+	if deadline, _ := ctx.Deadline(); time.Until(deadline) > time.Millisecond {
+		t.Errorf("storage deadline is %v away, want the RPC deadline", time.Until(deadline))
+	}
+}
+
+func TestStorageError(t *testing.T) {
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	tests := []struct {
+		ctx  context.Context
+		err  error
+		code codes.Code
+	}{
+		{context.Background(), errors.New("connection reset"), codes.Internal},
+		{context.Background(), context.DeadlineExceeded, codes.DeadlineExceeded},
+		{context.Background(), context.Canceled, codes.Canceled},
+		// the driver does not always wrap the context error
+		{expired, errors.New("server selection timeout"), codes.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		if got := status.Code(storageError(tt.ctx, tt.err, "failed")); got != tt.code {
+			t.Errorf("storageError(%v) = %v, want %v", tt.err, got, tt.code)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/minhtran241/grpc-go/blog/migrations"
+)
+
+var errNotFound = errors.New("blog not found")
+
+// blogStore keeps the blog documents. Every call runs under the given
+// context; Find, Replace and Delete return errNotFound for unknown IDs.
+// Delete returns the document it removed.
+// Stores are migration targets so the schema can be upgraded in place.
+type blogStore interface {
+	Insert(ctx context.Context, item *blogItem) (primitive.ObjectID, error)
+	Find(ctx context.Context, id primitive.ObjectID) (*blogItem, error)
+	Replace(ctx context.Context, item *blogItem) error
+	Delete(ctx context.Context, id primitive.ObjectID) (*blogItem, error)
+	// List calls fn with every blog until fn returns an error.
+	List(ctx context.Context, fn func(*blogItem) error) error
+
+	migrations.Target
+}
+
+// mongoStore keeps blogs in a MongoDB collection
+type mongoStore struct {
+	*migrations.MongoTarget
+	coll *mongo.Collection
+}
+
+func newMongoStore(coll *mongo.Collection) *mongoStore {
+	return &mongoStore{
+		MongoTarget: migrations.NewMongoTarget(coll),
+		coll:        coll,
+	}
+}
+
+func (s *mongoStore) Insert(ctx context.Context, item *blogItem) (primitive.ObjectID, error) {
+	res, err := s.coll.InsertOne(ctx, item)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, errors.New("cannot convert to OID")
+	}
+	return oid, nil
+}
+
+func (s *mongoStore) Find(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	// create an empty struct
+	data := &blogItem{}
+	filter := bson.D{{Key: "_id", Value: id}}
+	err := s.coll.FindOne(ctx, filter).Decode(data)
+	if err == mongo.ErrNoDocuments {
+		return nil, errNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *mongoStore) Replace(ctx context.Context, item *blogItem) error {
+	filter := bson.D{{Key: "_id", Value: item.ID}}
+	res, err := s.coll.ReplaceOne(ctx, filter, item)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+func (s *mongoStore) Delete(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	data := &blogItem{}
+	filter := bson.D{{Key: "_id", Value: id}}
+	err := s.coll.FindOneAndDelete(ctx, filter).Decode(data)
+	if err == mongo.ErrNoDocuments {
+		return nil, errNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// List bounds each batch fetched from the cursor by opTimeout rather than the whole listing.
+func (s *mongoStore) List(ctx context.Context, fn func(*blogItem) error) error {
+	findCtx, cancelFind := storageContext(ctx)
+	defer cancelFind()
+	cur, err := s.coll.Find(findCtx, bson.D{})
+	if err != nil {
+		if findCtx.Err() != nil {
+			return findCtx.Err()
+		}
+		return err
+	}
+	defer cur.Close(context.Background())
+
+	for {
+		nextCtx, cancelNext := storageContext(ctx)
+		more := cur.Next(nextCtx)
+		cancelNext()
+		if !more {
+			if nextCtx.Err() != nil {
+				return nextCtx.Err()
+			}
+			return cur.Err()
+		}
+
+		data := &blogItem{}
+		if err := cur.Decode(data); err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+}
+
+// memoryStore keeps blogs in process memory, which is handy for development
+// without a database. Documents are stored as BSON so that migrations see
+// them exactly as they would in MongoDB.
+type memoryStore struct {
+	mu      sync.RWMutex
+	docs    map[primitive.ObjectID]bson.Raw
+	order   []primitive.ObjectID // insertion order, used by List
+	applied []migrations.Record
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		docs: make(map[primitive.ObjectID]bson.Raw),
+	}
+}
+
+func (s *memoryStore) Insert(ctx context.Context, item *blogItem) (primitive.ObjectID, error) {
+	if err := ctx.Err(); err != nil {
+		return primitive.NilObjectID, err
+	}
+	doc := *item
+	doc.ID = primitive.NewObjectID()
+	raw, err := bson.Marshal(&doc)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs[doc.ID] = raw
+	s.order = append(s.order, doc.ID)
+	return doc.ID, nil
+}
+
+func (s *memoryStore) Find(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	raw, ok := s.docs[id]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, errNotFound
+	}
+
+	data := &blogItem{}
+	if err := bson.Unmarshal(raw, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *memoryStore) Replace(ctx context.Context, item *blogItem) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	raw, err := bson.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.docs[item.ID]; !ok {
+		return errNotFound
+	}
+	s.docs[item.ID] = raw
+	return nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, id primitive.ObjectID) (*blogItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	raw, ok := s.docs[id]
+	if !ok {
+		return nil, errNotFound
+	}
+	data := &blogItem{}
+	if err := bson.Unmarshal(raw, data); err != nil {
+		return nil, err
+	}
+	delete(s.docs, id)
+	for i, oid := range s.order {
+		if oid == id {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+	return data, nil
+}
+
+func (s *memoryStore) List(ctx context.Context, fn func(*blogItem) error) error {
+	// snapshot the documents so fn can call back into the store
+	s.mu.RLock()
+	raws := make([]bson.Raw, 0, len(s.order))
+	for _, id := range s.order {
+		raws = append(raws, s.docs[id])
+	}
+	s.mu.RUnlock()
+
+	for _, raw := range raws {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data := &blogItem{}
+		if err := bson.Unmarshal(raw, data); err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Update(ctx context.Context, fn func(doc bson.M) (bool, error)) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := 0
+	for _, id := range s.order {
+		if err := ctx.Err(); err != nil {
+			return changed, err
+		}
+		doc := bson.M{}
+		if err := bson.Unmarshal(s.docs[id], &doc); err != nil {
+			return changed, err
+		}
+		ok, err := fn(doc)
+		if err != nil {
+			return changed, err
+		}
+		if !ok {
+			continue
+		}
+		raw, err := bson.Marshal(doc)
+		if err != nil {
+			return changed, err
+		}
+		s.docs[id] = raw
+		changed++
+	}
+	return changed, nil
+}
+
+func (s *memoryStore) Applied(ctx context.Context) ([]migrations.Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]migrations.Record(nil), s.applied...), nil
+}
+
+func (s *memoryStore) Record(ctx context.Context, rec migrations.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, applied := range s.applied {
+		if applied.Version == rec.Version {
+			return nil
+		}
+	}
+	s.applied = append(s.applied, rec)
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/minhtran241/grpc-go/blog/migrations"
+)
+
+func TestMemoryStoreMigrations(t *testing.T) {
+	s := newMemoryStore()
+	ctx := context.Background()
+	if _, err := s.Insert(ctx, &blogItem{Title: "old"}); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	results, err := migrations.Run(ctx, s, migrations.Blog, false, io.Discard)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(results) != len(migrations.Blog) {
+		t.Errorf("Run applied %d migrations, want %d", len(results), len(migrations.Blog))
+	}
+	if results, err := migrations.Run(ctx, s, migrations.Blog, false, io.Discard); err != nil || len(results) != 0 {
+		t.Errorf("second Run = %+v, %v, want nothing to do", results, err)
+	}
+
+	// a version recorded concurrently by another run keeps its first record
+	if err := s.Record(ctx, migrations.Record{Version: 1, Description: "again"}); err != nil {
+		t.Errorf("recording a version again failed: %v", err)
+	}
+	applied, err := s.Applied(ctx)
+	if err != nil {
+		t.Fatalf("Applied failed: %v", err)
+	}
+	if len(applied) != len(migrations.Blog) || applied[0].Description == "again" {
+		t.Errorf("Applied = %+v, want each migration recorded once", applied)
+	}
+}
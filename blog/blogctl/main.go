@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/minhtran241/grpc-go/blog/config"
+	"github.com/minhtran241/grpc-go/blog/migrations"
+)
+
+const usage = `Usage: blogctl <command> [flags]
+
+Commands:
+  migrate    apply pending schema migrations to the blog collection
+
+Run "blogctl <command> -h" for the flags of a command.
+`
+
+func main() {
+	log.SetFlags(0)
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "migrate":
+		if err := migrate(os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%v", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+func migrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := fs.Bool("dry_run", false, "report what would change without writing anything")
+	// the MongoDB settings are the server's, from the same flags, environment and config file
+	cfg, err := config.Load(fs, args)
+	if err != nil {
+		return err
+	}
+	if cfg.Store != "mongo" {
+		// the memory store lives in the server process, it migrates itself on start
+		return fmt.Errorf("migrate only works on the mongo store, not %q", cfg.Store)
+	}
+
+	client, err := config.ConnectMongo(cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Disconnect(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.MigrateTimeout)
+	defer cancel()
+
+	target := migrations.NewMongoTarget(client.Database(cfg.Database).Collection(cfg.Collection))
+	results, err := migrations.Run(ctx, target, migrations.Blog, *dryRun, os.Stdout)
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		fmt.Printf("Dry run: %d migrations pending, nothing was written\n", len(results))
+	} else {
+		fmt.Printf("Applied %d migrations\n", len(results))
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrateMemoryStore(t *testing.T) {
+	err := migrate([]string{"-store", "memory"})
+	if err == nil || !strings.Contains(err.Error(), "mongo store") {
+		t.Errorf("migrate with the memory store = %v, want it rejected", err)
+	}
+}
@@ -27,6 +27,7 @@ type BlogServiceClient interface {
 	UpdateBlog(ctx context.Context, in *UpdateBlogRequest, opts ...grpc.CallOption) (*UpdateBlogResponse, error)
 	DeleteBlog(ctx context.Context, in *DeleteBlogRequest, opts ...grpc.CallOption) (*DeleteBlogResponse, error)
 	ListBlog(ctx context.Context, in *ListBlogRequest, opts ...grpc.CallOption) (BlogService_ListBlogClient, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (BlogService_ListAuditEventsClient, error)
 }
 
 type blogServiceClient struct {
@@ -105,6 +106,38 @@ func (x *blogServiceListBlogClient) Recv() (*ListBlogResponse, error) {
 	return m, nil
 }
 
+func (c *blogServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (BlogService_ListAuditEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlogService_ServiceDesc.Streams[1], "/blog.BlogService/ListAuditEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogServiceListAuditEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogService_ListAuditEventsClient interface {
+	Recv() (*ListAuditEventsResponse, error)
+	grpc.ClientStream
+}
+
+type blogServiceListAuditEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogServiceListAuditEventsClient) Recv() (*ListAuditEventsResponse, error) {
+	m := new(ListAuditEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BlogServiceServer is the server API for BlogService service.
 // All implementations should embed UnimplementedBlogServiceServer
 // for forward compatibility
@@ -114,6 +147,7 @@ type BlogServiceServer interface {
 	UpdateBlog(context.Context, *UpdateBlogRequest) (*UpdateBlogResponse, error)
 	DeleteBlog(context.Context, *DeleteBlogRequest) (*DeleteBlogResponse, error)
 	ListBlog(*ListBlogRequest, BlogService_ListBlogServer) error
+	ListAuditEvents(*ListAuditEventsRequest, BlogService_ListAuditEventsServer) error
 }
 
 // UnimplementedBlogServiceServer should be embedded to have forward compatible implementations.
@@ -135,6 +169,9 @@ func (UnimplementedBlogServiceServer) DeleteBlog(context.Context, *DeleteBlogReq
 func (UnimplementedBlogServiceServer) ListBlog(*ListBlogRequest, BlogService_ListBlogServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListBlog not implemented")
 }
+func (UnimplementedBlogServiceServer) ListAuditEvents(*ListAuditEventsRequest, BlogService_ListAuditEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 
 // UnsafeBlogServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BlogServiceServer will
@@ -240,6 +277,27 @@ func (x *blogServiceListBlogServer) Send(m *ListBlogResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _BlogService_ListAuditEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogServiceServer).ListAuditEvents(m, &blogServiceListAuditEventsServer{stream})
+}
+
+type BlogService_ListAuditEventsServer interface {
+	Send(*ListAuditEventsResponse) error
+	grpc.ServerStream
+}
+
+type blogServiceListAuditEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogServiceListAuditEventsServer) Send(m *ListAuditEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // BlogService_ServiceDesc is the grpc.ServiceDesc for BlogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -270,6 +328,11 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BlogService_ListBlog_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListAuditEvents",
+			Handler:       _BlogService_ListAuditEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "blog/blogpb/blog.proto",
 }
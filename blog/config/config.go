@@ -0,0 +1,170 @@
+// Package config holds the blog settings shared by blog_server and blogctl,
+// and opens the MongoDB client they describe.
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Config holds the blog server settings.
+// Every setting is a flag; the same name is accepted as a key in the JSON
+// config file and, upper-cased with a BLOG_ prefix, as an environment variable.
+// Precedence is flags, then environment, then config file, then defaults.
+type Config struct {
+	Store string
+
+	URI        string
+	Username   string
+	Password   string
+	AuthSource string
+	Database   string
+	Collection string
+
+	AuditCollection string
+
+	MinPoolSize uint64
+	MaxPoolSize uint64
+
+	ConnectTimeout         time.Duration
+	ServerSelectionTimeout time.Duration
+	OpTimeout              time.Duration
+	HealthCheckInterval    time.Duration
+
+	CacheMaxBytes int64
+	CacheTTL      time.Duration
+
+	DebugAddr string
+
+	MigrateOnStart bool
+	MigrateTimeout time.Duration
+}
+
+const envPrefix = "BLOG_"
+
+func (c *Config) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Store, "store", "mongo", "where blogs are kept: mongo or memory")
+	fs.StringVar(&c.URI, "mongo_uri", "mongodb://localhost:27017", "MongoDB connection string")
+	fs.StringVar(&c.Username, "mongo_username", "", "MongoDB user name, empty to use the URI as is")
+	fs.StringVar(&c.Password, "mongo_password", "", "MongoDB password")
+	fs.StringVar(&c.AuthSource, "mongo_auth_source", "", "database to authenticate against, defaults to the driver's choice")
+	fs.StringVar(&c.Database, "mongo_database", "mydb", "database holding the blogs")
+	fs.StringVar(&c.Collection, "mongo_collection", "blog", "collection holding the blogs")
+	fs.StringVar(&c.AuditCollection, "mongo_audit_collection", "audit_events", "collection holding the audit log")
+	fs.Uint64Var(&c.MinPoolSize, "mongo_min_pool_size", 0, "minimum number of pooled connections per server")
+	fs.Uint64Var(&c.MaxPoolSize, "mongo_max_pool_size", 100, "maximum number of pooled connections per server")
+	fs.DurationVar(&c.ConnectTimeout, "mongo_connect_timeout", 10*time.Second, "timeout for establishing a connection")
+	fs.DurationVar(&c.ServerSelectionTimeout, "mongo_server_selection_timeout", 5*time.Second, "how long to wait for a usable server")
+	fs.DurationVar(&c.OpTimeout, "op_timeout", 5*time.Second, "maximum duration of a single MongoDB operation")
+	fs.DurationVar(&c.HealthCheckInterval, "health_check_interval", 10*time.Second, "how often MongoDB is pinged to report the health status")
+	fs.Int64Var(&c.CacheMaxBytes, "cache_max_bytes", 32<<20, "size of the ReadBlog cache in bytes")
+	fs.DurationVar(&c.CacheTTL, "cache_ttl", 5*time.Minute, "how long a cached blog stays fresh")
+	fs.StringVar(&c.DebugAddr, "debug_addr", "localhost:8081", "address serving the expvar counters at /debug/vars, empty to disable")
+	fs.BoolVar(&c.MigrateOnStart, "migrate_on_start", true, "apply pending schema migrations before serving")
+	fs.DurationVar(&c.MigrateTimeout, "migrate_timeout", 10*time.Minute, "maximum duration of a schema migration run")
+}
+
+// Load parses args and merges in the config file and environment.
+// Flags that only some commands have can be defined on fs beforehand,
+// they are read from the environment and the config file the same way.
+func Load(fs *flag.FlagSet, args []string) (*Config, error) {
+	cfg := &Config{}
+	path := fs.String("config", os.Getenv(envPrefix+"CONFIG"), "path to a JSON config file")
+	cfg.bindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	// remember the flags given on the command line, they are applied last
+	explicit := map[string]string{}
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = f.Value.String()
+	})
+
+	if *path != "" {
+		if err := applyConfigFile(fs, *path); err != nil {
+			return nil, err
+		}
+	}
+
+	var envErr error
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" || envErr != nil {
+			return
+		}
+		name := envPrefix + strings.ToUpper(f.Name)
+		if v, ok := os.LookupEnv(name); ok {
+			if err := f.Value.Set(v); err != nil {
+				envErr = fmt.Errorf("invalid value %q for %v: %v", v, name, err)
+			}
+		}
+	})
+	if envErr != nil {
+		return nil, envErr
+	}
+
+	for name, v := range explicit {
+		if err := fs.Set(name, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func applyConfigFile(fs *flag.FlagSet, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("cannot open config file: %v", err)
+	}
+	defer f.Close()
+
+	values := map[string]interface{}{}
+	dec := json.NewDecoder(f)
+	dec.UseNumber() // keep large integers exact
+	if err := dec.Decode(&values); err != nil {
+		return fmt.Errorf("cannot parse config file %v: %v", path, err)
+	}
+	for key, v := range values {
+		if key == "config" || fs.Lookup(key) == nil {
+			return fmt.Errorf("unknown setting %q in config file %v", key, path)
+		}
+		if err := fs.Set(key, fmt.Sprint(v)); err != nil {
+			return fmt.Errorf("invalid value %v for %q in config file %v: %v", v, key, path, err)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validate() error {
+	switch {
+	case c.Store != "mongo" && c.Store != "memory":
+		return fmt.Errorf("store must be mongo or memory, got %q", c.Store)
+	case c.URI == "":
+		return fmt.Errorf("mongo_uri must be set")
+	case c.Database == "":
+		return fmt.Errorf("mongo_database must be set")
+	case c.Collection == "":
+		return fmt.Errorf("mongo_collection must be set")
+	case c.AuditCollection == "" || c.AuditCollection == c.Collection:
+		return fmt.Errorf("mongo_audit_collection must be set and differ from mongo_collection")
+	case c.MaxPoolSize != 0 && c.MinPoolSize > c.MaxPoolSize:
+		return fmt.Errorf("mongo_min_pool_size (%d) is larger than mongo_max_pool_size (%d)", c.MinPoolSize, c.MaxPoolSize)
+	case c.OpTimeout <= 0:
+		return fmt.Errorf("op_timeout must be positive")
+	case c.HealthCheckInterval <= 0:
+		return fmt.Errorf("health_check_interval must be positive")
+	case c.MigrateTimeout <= 0:
+		return fmt.Errorf("migrate_timeout must be positive")
+	case c.CacheMaxBytes < 0:
+		return fmt.Errorf("cache_max_bytes must not be negative")
+	}
+	return nil
+}
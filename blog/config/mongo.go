@@ -0,0 +1,56 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// ConnectMongo opens a client for cfg and checks that the primary answers,
+// so an unreachable database fails at startup instead of on the first RPC.
+func ConnectMongo(cfg *Config) (*mongo.Client, error) {
+	opts := options.Client().
+		ApplyURI(cfg.URI).
+		SetMinPoolSize(cfg.MinPoolSize).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetServerSelectionTimeout(cfg.ServerSelectionTimeout)
+	if cfg.Username != "" {
+		opts.SetAuth(options.Credential{
+			AuthSource: cfg.AuthSource,
+			Username:   cfg.Username,
+			Password:   cfg.Password,
+		})
+	}
+
+	client, err := mongo.Connect(context.Background(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create MongoDB client: %v", err)
+	}
+
+	if err := PingMongo(client, cfg.ConnectTimeout); err != nil {
+		client.Disconnect(context.Background())
+		return nil, fmt.Errorf("MongoDB is not reachable at %v: %v", RedactURI(cfg.URI), err)
+	}
+	return client, nil
+}
+
+// PingMongo checks that the primary answers within timeout.
+func PingMongo(client *mongo.Client, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return client.Ping(ctx, readpref.Primary())
+}
+
+// RedactURI hides the password of a connection string before it is logged.
+func RedactURI(uri string) string {
+	opts := options.Client().ApplyURI(uri)
+	if opts.Auth == nil || !opts.Auth.PasswordSet {
+		return uri
+	}
+	return "<redacted uri for hosts " + fmt.Sprint(opts.Hosts) + ">"
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Blog lists the migrations of the blog documents.
+// Append new migrations with the next version; never edit or reorder applied ones.
+var Blog = []Migration{
+	{
+		Version:     1,
+		Description: "backfill created_at and updated_at from the document ID",
+		Up:          backfillTimestamps,
+	},
+}
+
+// backfillTimestamps gives documents written before blogs carried timestamps
+// the creation time embedded in their ObjectID.
+func backfillTimestamps(ctx context.Context, docs Documents) error {
+	_, err := docs.Update(ctx, func(doc bson.M) (bool, error) {
+		if _, ok := doc["created_at"]; ok {
+			return false, nil
+		}
+		created := time.Now().UTC()
+		if oid, ok := doc["_id"].(primitive.ObjectID); ok {
+			created = oid.Timestamp().UTC()
+		}
+		doc["created_at"] = created
+		if _, ok := doc["updated_at"]; !ok {
+			doc["updated_at"] = created
+		}
+		return true, nil
+	})
+	return err
+}
@@ -0,0 +1,182 @@
+// Package migrations runs versioned schema migrations over blog documents.
+//
+// Every migration has a unique, increasing version. The versions that were
+// applied are recorded by the Target, so running the migrations again only
+// applies the pending ones. Migrations must be idempotent: a migration that
+// fails half way is retried from the start on the next run.
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Migration is one versioned change to the blog documents.
+type Migration struct {
+	Version     int
+	Description string
+	// Up applies the migration. It must only touch documents through docs,
+	// so that dry runs do not write anything.
+	Up func(ctx context.Context, docs Documents) error
+}
+
+// Documents is the view of the blog collection a migration works on.
+type Documents interface {
+	// Update calls fn with every document and writes back the ones for
+	// which fn returns true. It returns the number of changed documents.
+	Update(ctx context.Context, fn func(doc bson.M) (bool, error)) (int, error)
+}
+
+// Target is a blog store that migrations can run against.
+type Target interface {
+	Documents
+	// Applied returns the migrations recorded as applied to these documents,
+	// not to other collections that share the same bookkeeping.
+	Applied(ctx context.Context) ([]Record, error)
+	// Record marks a migration as applied. Recording a version again succeeds
+	// and keeps the first record: servers starting together may run the same
+	// migration, which is safe as migrations are idempotent, and the one that
+	// records it second must not fail.
+	Record(ctx context.Context, rec Record) error
+}
+
+// Record is the bookkeeping entry written for an applied migration.
+type Record struct {
+	Version     int       `bson:"version"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+	Changed     int       `bson:"changed"`
+}
+
+// Result describes what running one migration did, or would do in a dry run.
+type Result struct {
+	Migration Migration
+	Changed   int
+}
+
+// Pending returns the migrations in all that target has not applied yet, in version order.
+func Pending(ctx context.Context, target Target, all []Migration) ([]Migration, error) {
+	if err := validate(all); err != nil {
+		return nil, err
+	}
+	applied, err := target.Applied(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read applied migrations: %v", err)
+	}
+	done := make(map[int]bool, len(applied))
+	for _, rec := range applied {
+		done[rec.Version] = true
+	}
+
+	pending := []Migration{}
+	for _, m := range sorted(all) {
+		if !done[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Run applies the pending migrations in version order and stops at the first failure.
+// With dryRun set the migrations run against a read-only view of the documents and
+// nothing is recorded; the results report how many documents would change. In a dry run
+// every pending migration sees the stored documents, not the output of the previous one.
+// Progress is written to out.
+func Run(ctx context.Context, target Target, all []Migration, dryRun bool, out io.Writer) ([]Result, error) {
+	pending, err := Pending(ctx, target, all)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		fmt.Fprintln(out, "No pending migrations")
+		return nil, nil
+	}
+
+	var docs Documents = target
+	if dryRun {
+		docs = &dryRunDocuments{target: target}
+	}
+
+	results := []Result{}
+	for _, m := range pending {
+		fmt.Fprintf(out, "Applying migration %d (%v)...\n", m.Version, m.Description)
+		counter := &countingDocuments{docs: docs}
+		if err := m.Up(ctx, counter); err != nil {
+			return results, fmt.Errorf("migration %d (%v) failed: %v", m.Version, m.Description, err)
+		}
+		results = append(results, Result{Migration: m, Changed: counter.changed})
+
+		if dryRun {
+			fmt.Fprintf(out, "Migration %d would change %d documents\n", m.Version, counter.changed)
+			continue
+		}
+		rec := Record{
+			Version:     m.Version,
+			Description: m.Description,
+			AppliedAt:   time.Now().UTC(),
+			Changed:     counter.changed,
+		}
+		if err := target.Record(ctx, rec); err != nil {
+			return results, fmt.Errorf("cannot record migration %d: %v", m.Version, err)
+		}
+		fmt.Fprintf(out, "Migration %d changed %d documents\n", m.Version, counter.changed)
+	}
+	return results, nil
+}
+
+func validate(all []Migration) error {
+	seen := map[int]bool{}
+	for _, m := range all {
+		if m.Version <= 0 {
+			return fmt.Errorf("migration %q has invalid version %d", m.Description, m.Version)
+		}
+		if seen[m.Version] {
+			return fmt.Errorf("migration version %d is used twice", m.Version)
+		}
+		if m.Up == nil {
+			return fmt.Errorf("migration %d has no Up function", m.Version)
+		}
+		seen[m.Version] = true
+	}
+	return nil
+}
+
+func sorted(all []Migration) []Migration {
+	res := append([]Migration(nil), all...)
+	sort.Slice(res, func(i, j int) bool { return res[i].Version < res[j].Version })
+	return res
+}
+
+// countingDocuments counts the documents changed through it.
+type countingDocuments struct {
+	docs    Documents
+	changed int
+}
+
+func (c *countingDocuments) Update(ctx context.Context, fn func(doc bson.M) (bool, error)) (int, error) {
+	n, err := c.docs.Update(ctx, fn)
+	c.changed += n
+	return n, err
+}
+
+// dryRunDocuments lets fn inspect and modify every document but never writes back.
+type dryRunDocuments struct {
+	target Target
+}
+
+func (d *dryRunDocuments) Update(ctx context.Context, fn func(doc bson.M) (bool, error)) (int, error) {
+	changed := 0
+	_, err := d.target.Update(ctx, func(doc bson.M) (bool, error) {
+		ok, err := fn(doc)
+		if ok {
+			changed++
+		}
+		return false, err
+	})
+	return changed, err
+}
@@ -0,0 +1,204 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakeTarget keeps documents and records in memory
+type fakeTarget struct {
+	docs    []bson.M
+	records []Record
+}
+
+func (f *fakeTarget) Update(ctx context.Context, fn func(doc bson.M) (bool, error)) (int, error) {
+	changed := 0
+	for i, doc := range f.docs {
+		// fn works on a copy, as it would on a decoded document
+		cp := bson.M{}
+		for k, v := range doc {
+			cp[k] = v
+		}
+		ok, err := fn(cp)
+		if err != nil {
+			return changed, err
+		}
+		if ok {
+			f.docs[i] = cp
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+func (f *fakeTarget) Applied(ctx context.Context) ([]Record, error) {
+	return f.records, nil
+}
+
+func (f *fakeTarget) Record(ctx context.Context, rec Record) error {
+	f.records = append(f.records, rec)
+	return nil
+}
+
+// setField is a migration that sets key on every document without it
+func setField(version int, key string) Migration {
+	return Migration{
+		Version:     version,
+		Description: "set " + key,
+		Up: func(ctx context.Context, docs Documents) error {
+			_, err := docs.Update(ctx, func(doc bson.M) (bool, error) {
+				if _, ok := doc[key]; ok {
+					return false, nil
+				}
+				doc[key] = version
+				return true, nil
+			})
+			return err
+		},
+	}
+}
+
+func recordedVersions(records []Record) []int {
+	versions := []int{}
+	for _, rec := range records {
+		versions = append(versions, rec.Version)
+	}
+	return versions
+}
+
+func TestRun(t *testing.T) {
+	target := &fakeTarget{docs: []bson.M{{"a": 0}, {}}}
+	// out of order on purpose, they run by version
+	all := []Migration{setField(2, "b"), setField(1, "a")}
+
+	results, err := Run(context.Background(), target, all, false, io.Discard)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(results) != 2 || results[0].Migration.Version != 1 || results[0].Changed != 1 || results[1].Changed != 2 {
+		t.Errorf("Run = %+v, want migration 1 changing 1 document, then 2 changing 2", results)
+	}
+	if want := []bson.M{{"a": 0, "b": 2}, {"a": 1, "b": 2}}; !reflect.DeepEqual(target.docs, want) {
+		t.Errorf("documents = %v, want %v", target.docs, want)
+	}
+	if got := recordedVersions(target.records); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("recorded versions %v, want [1 2]", got)
+	}
+
+	// only new migrations run the next time
+	all = append(all, setField(3, "c"))
+	results, err = Run(context.Background(), target, all, false, io.Discard)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Migration.Version != 3 {
+		t.Errorf("second Run = %+v, want only migration 3", results)
+	}
+	if results, err := Run(context.Background(), target, all, false, io.Discard); err != nil || len(results) != 0 {
+		t.Errorf("third Run = %+v, %v, want nothing to do", results, err)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	target := &fakeTarget{docs: []bson.M{{"a": 0}, {}}}
+	results, err := Run(context.Background(), target, []Migration{setField(1, "a"), setField(2, "b")}, true, io.Discard)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(results) != 2 || results[0].Changed != 1 || results[1].Changed != 2 {
+		t.Errorf("Run = %+v, want 1 and 2 documents that would change", results)
+	}
+	if want := []bson.M{{"a": 0}, {}}; !reflect.DeepEqual(target.docs, want) {
+		t.Errorf("a dry run changed the documents to %v", target.docs)
+	}
+	if len(target.records) != 0 {
+		t.Errorf("a dry run recorded %v", target.records)
+	}
+}
+
+func TestRunStopsAtFailure(t *testing.T) {
+	target := &fakeTarget{docs: []bson.M{{}}}
+	failing := Migration{
+		Version:     2,
+		Description: "fail",
+		Up: func(ctx context.Context, docs Documents) error {
+			return errors.New("broken")
+		},
+	}
+	results, err := Run(context.Background(), target, []Migration{setField(1, "a"), failing, setField(3, "c")}, false, io.Discard)
+	if err == nil {
+		t.Fatalf("Run succeeded, want the error of migration 2")
+	}
+	if len(results) != 1 {
+		t.Errorf("Run = %+v, want only migration 1", results)
+	}
+	if got := recordedVersions(target.records); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("recorded versions %v, want [1]", got)
+	}
+	if _, ok := target.docs[0]["c"]; ok {
+		t.Errorf("migration 3 ran after migration 2 failed")
+	}
+}
+
+func TestPendingInvalidMigrations(t *testing.T) {
+	tests := [][]Migration{
+		{setField(0, "a")},
+		{setField(1, "a"), setField(1, "b")},
+		{{Version: 1, Description: "no Up"}},
+	}
+	for _, all := range tests {
+		if _, err := Pending(context.Background(), &fakeTarget{}, all); err == nil {
+			t.Errorf("Pending(%+v) succeeded, want an error", all)
+		}
+	}
+}
+
+func TestBackfillTimestamps(t *testing.T) {
+	oid := primitive.NewObjectIDFromTimestamp(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	stamped := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	target := &fakeTarget{docs: []bson.M{
+		{"_id": oid},
+		{"_id": primitive.NewObjectID(), "created_at": stamped, "updated_at": stamped},
+	}}
+	results, err := Run(context.Background(), target, Blog, false, io.Discard)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Changed != 1 {
+		t.Errorf("Run = %+v, want one document changed", results)
+	}
+	if got := target.docs[0]["created_at"]; got != oid.Timestamp().UTC() || target.docs[0]["updated_at"] != got {
+		t.Errorf("backfilled created_at %v and updated_at %v, want %v", got, target.docs[0]["updated_at"], oid.Timestamp().UTC())
+	}
+	if got := target.docs[1]["created_at"]; got != stamped {
+		t.Errorf("created_at of a stamped document changed to %v", got)
+	}
+}
+
+func TestMongoRecordPerCollection(t *testing.T) {
+	raw, err := bson.Marshal(mongoRecord{
+		ID:     mongoRecordID{Collection: "blog", Version: 3},
+		Record: Record{Version: 3, Description: "d"},
+	})
+	if err != nil {
+		t.Fatalf("cannot marshal the record: %v", err)
+	}
+	var stored struct {
+		ID      bson.M `bson:"_id"`
+		Version int    `bson:"version"`
+	}
+	if err := bson.Unmarshal(raw, &stored); err != nil {
+		t.Fatalf("cannot unmarshal the record: %v", err)
+	}
+	// the key holds the collection, so other collections of the database are migrated separately
+	if want := (bson.M{"collection": "blog", "version": int32(3)}); !reflect.DeepEqual(stored.ID, want) || stored.Version != 3 {
+		t.Errorf("stored record has _id %v and version %d, want _id %v and version 3", stored.ID, stored.Version, want)
+	}
+}
@@ -0,0 +1,101 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrationsCollection is the collection applied migrations are recorded in.
+const MigrationsCollection = "migrations"
+
+// MongoTarget runs migrations against a MongoDB collection.
+type MongoTarget struct {
+	docs    *mongo.Collection
+	applied *mongo.Collection
+}
+
+// mongoRecord is a Record as stored, keyed by the blog collection and the
+// version so that the collections of a database are migrated separately.
+// Records of earlier releases were keyed by the version alone; they match no
+// collection, so their migrations run once more, which they allow by being
+// idempotent.
+type mongoRecord struct {
+	ID     mongoRecordID `bson:"_id"`
+	Record `bson:",inline"`
+}
+
+type mongoRecordID struct {
+	Collection string `bson:"collection"`
+	Version    int    `bson:"version"`
+}
+
+// NewMongoTarget returns a target for the blog documents in coll.
+// Applied migrations are recorded in MigrationsCollection of the same database,
+// under the name of coll.
+func NewMongoTarget(coll *mongo.Collection) *MongoTarget {
+	return &MongoTarget{
+		docs:    coll,
+		applied: coll.Database().Collection(MigrationsCollection),
+	}
+}
+
+func (t *MongoTarget) Update(ctx context.Context, fn func(doc bson.M) (bool, error)) (int, error) {
+	cur, err := t.docs.Find(ctx, bson.D{})
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(context.Background())
+
+	changed := 0
+	for cur.Next(ctx) {
+		doc := bson.M{}
+		if err := cur.Decode(&doc); err != nil {
+			return changed, err
+		}
+		ok, err := fn(doc)
+		if err != nil {
+			return changed, err
+		}
+		if !ok {
+			continue
+		}
+		filter := bson.D{{Key: "_id", Value: doc["_id"]}}
+		if _, err := t.docs.ReplaceOne(ctx, filter, doc); err != nil {
+			return changed, err
+		}
+		changed++
+	}
+	return changed, cur.Err()
+}
+
+func (t *MongoTarget) Applied(ctx context.Context) ([]Record, error) {
+	filter := bson.D{{Key: "_id.collection", Value: t.docs.Name()}}
+	cur, err := t.applied.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id.version", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	stored := []mongoRecord{}
+	if err := cur.All(ctx, &stored); err != nil {
+		return nil, err
+	}
+	records := make([]Record, len(stored))
+	for i, rec := range stored {
+		records[i] = rec.Record
+	}
+	return records, nil
+}
+
+func (t *MongoTarget) Record(ctx context.Context, rec Record) error {
+	_, err := t.applied.InsertOne(ctx, mongoRecord{
+		ID:     mongoRecordID{Collection: t.docs.Name(), Version: rec.Version},
+		Record: rec,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// another process applied the same migration first
+		return nil
+	}
+	return err
+}
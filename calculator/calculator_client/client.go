@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
+	"math"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,6 +18,11 @@ import (
 )
 
 func main() {
+	mode := flag.String("mode", "error", "demo to run: unary, server_streaming, client_streaming, bidi_streaming, error, evaluate, big_numbers, statistics, window, session, primes, number_theory, matrix, regression, calculus, units, domains, batch, cache, histogram, notation, random, symbolic or checked")
+	from := flag.Uint64("from", 0, "start of the range of primes, for -mode primes")
+	to := flag.Uint64("to", 100, "end of the range of primes, exclusive, for -mode primes")
+	limit := flag.Uint64("limit", 0, "the most primes to receive, 0 for all of them, for -mode primes")
+	flag.Parse()
 
 	cc, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
 
@@ -26,15 +34,723 @@ func main() {
 
 	c := calculatorpb.NewCalculatorServiceClient(cc)
 
-	// doUnary(c)
+	switch *mode {
+	case "unary":
+		doUnary(c)
+	case "server_streaming":
+		doServerStreaming(c)
+	case "client_streaming":
+		doClientStreaming(c)
+	case "bidi_streaming":
+		doBiDiStreaming(c)
+	case "error":
+		doErrorUnary(c)
+	case "evaluate":
+		doEvaluate(c)
+	case "big_numbers":
+		doBigNumbers(c)
+	case "statistics":
+		doComputeStatistics(c)
+	case "window":
+		doWindowedAggregate(c)
+	case "session":
+		doSession(c)
+	case "primes":
+		doGeneratePrimes(c, *from, *to, *limit)
+	case "number_theory":
+		doNumberTheory(c)
+	case "matrix":
+		doMatrix(c)
+	case "regression":
+		doFitLinearRegression(c)
+	case "calculus":
+		doCalculus(c)
+	case "units":
+		doUnits(c)
+	case "domains":
+		doNumberDomains(c)
+	case "batch":
+		doBatchEvaluate(c)
+	case "cache":
+		doCache(c, calculatorpb.NewCalculatorAdminServiceClient(cc))
+	case "histogram":
+		doBuildHistogram(c)
+	case "notation":
+		doNumberNotation(c)
+	case "random":
+		doRandomNumbers(c)
+	case "symbolic":
+		doSymbolic(c)
+	case "checked":
+		doIntegerArithmetic(c)
+	default:
+		log.Fatalf("Unknown mode %q", *mode)
+	}
+}
+
+func doGeneratePrimes(c calculatorpb.CalculatorServiceClient, from, to, limit uint64) {
+	fmt.Println("Starting to do GeneratePrimes Server Streaming RPC...")
+
+	req := &calculatorpb.GeneratePrimesRequest{
+		From:  from,
+		To:    to,
+		Limit: limit,
+	}
+
+	resStream, err := c.GeneratePrimes(context.Background(), req)
+
+	if err != nil {
+		log.Fatalf("Error while calling GeneratePrimes RPC: %v", err)
+	}
+
+	count := 0
+	for {
+		res, err := resStream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			log.Fatalf("Error while reading stream: %v", err)
+		}
+
+		for _, prime := range res.GetPrimes() {
+			fmt.Println(prime)
+		}
+		count += len(res.GetPrimes())
+	}
+	fmt.Printf("Received %d primes in [%d, %d)\n", count, from, to)
+}
+
+func doSession(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do Session BiDi Streaming RPC...")
+
+	stream, err := c.Session(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while opening stream and calling Session: %v", err)
+	}
+
+	statements := []string{"x = 3", "y = x^2 + 1", "def f(a) = a*2", "f(y) - x", "f(z)"}
+
+	for _, statement := range statements {
+		stream.Send(&calculatorpb.SessionRequest{
+			Statement: statement,
+		})
+
+		res, err := stream.Recv()
+
+		if err != nil {
+			log.Fatalf("Error while receiving session response: %v", err)
+		}
+
+		switch outcome := res.GetOutcome().(type) {
+		case *calculatorpb.SessionResponse_Result:
+			fmt.Printf("> %v\n%v\n", statement, outcome.Result)
+		case *calculatorpb.SessionResponse_Function:
+			fmt.Printf("> %v\ndefined %v\n", statement, outcome.Function)
+		case *calculatorpb.SessionResponse_Error:
+			fmt.Printf("> %v\nerror at column %d: %v\n", statement, outcome.Error.GetColumn(), outcome.Error.GetMessage())
+		}
+	}
+	stream.CloseSend()
+}
+
+func doWindowedAggregate(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do WindowedAggregate BiDi Streaming RPC...")
+
+	stream, err := c.WindowedAggregate(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while opening stream and calling WindowedAggregate: %v", err)
+	}
+
+	// the first message configures the window: the 3 largest of the last 5 numbers
+	err = stream.Send(&calculatorpb.WindowedAggregateRequest{
+		Payload: &calculatorpb.WindowedAggregateRequest_Config{
+			Config: &calculatorpb.WindowConfig{
+				Window:    &calculatorpb.WindowConfig_Count{Count: 5},
+				Aggregate: calculatorpb.WindowAggregate_WINDOW_AGGREGATE_TOP_K,
+				K:         3,
+			},
+		},
+	})
+
+	if err != nil {
+		log.Fatalf("Error while sending window config: %v", err)
+	}
+
+	numbers := []float64{4, 7, 2, 19, 4, 6, 32, 1, 8}
+
+	for _, number := range numbers {
+		stream.Send(&calculatorpb.WindowedAggregateRequest{
+			Payload: &calculatorpb.WindowedAggregateRequest_Number{Number: number},
+		})
+
+		res, err := stream.Recv()
+
+		if err != nil {
+			log.Fatalf("Error while receiving window aggregate: %v", err)
+		}
+
+		fmt.Printf("Sent %v, top 3 of the last %d numbers: %v\n", number, res.GetWindowSize(), res.GetTop())
+	}
+	stream.CloseSend()
+}
+
+func doComputeStatistics(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do ComputeStatistics Client Streaming RPC...")
+
+	stream, err := c.ComputeStatistics(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while opening client stream: %v", err)
+	}
+
+	numbers := []float64{3, 5, 9, 54, 23, 1.5, 7.25}
+
+	for i, number := range numbers {
+		req := &calculatorpb.ComputeStatisticsRequest{
+			Number: number,
+		}
+		if i == 0 {
+			// percentiles are configured by the first message
+			req.Percentiles = []float64{10, 50, 90}
+		}
+		fmt.Printf("Sending number: %v\n", number)
+		stream.Send(req)
+	}
+
+	res, err := stream.CloseAndRecv()
+
+	if err != nil {
+		log.Fatalf("Error while receiving response: %v", err)
+	}
+
+	fmt.Printf("Statistics: %v\n", res)
+}
+
+func doUnits(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do unit-aware Unary RPCs...")
+
+	evalRes, err := c.EvaluateQuantity(context.Background(), &calculatorpb.EvaluateQuantityRequest{
+		Expression: "3 km + 250 m",
+	})
+	if err != nil {
+		log.Fatalf("error while calling EvaluateQuantity RPC: %v", err)
+	}
+	fmt.Printf("3 km + 250 m = %v %v\n", evalRes.GetResult().GetValue(), evalRes.GetResult().GetUnit())
+
+	convRes, err := c.Convert(context.Background(), &calculatorpb.ConvertRequest{
+		Quantity: &calculatorpb.Quantity{Value: 100, Unit: "degC"},
+		Unit:     "degF",
+	})
+	if err != nil {
+		log.Fatalf("error while calling Convert RPC: %v", err)
+	}
+	fmt.Printf("100 degC = %v %v\n", convRes.GetQuantity().GetValue(), convRes.GetQuantity().GetUnit())
+
+	// a length and a mass cannot be added
+	_, err = c.EvaluateQuantity(context.Background(), &calculatorpb.EvaluateQuantityRequest{
+		Expression: "3 km + 2 kg",
+	})
+	if err != nil {
+		respErr, ok := status.FromError(err)
+		if !ok {
+			log.Fatalf("error while calling EvaluateQuantity RPC: %v", err)
+		}
+		fmt.Printf("3 km + 2 kg failed: %v %v\n", respErr.Code(), respErr.Message())
+	}
+}
+
+func doNumberDomains(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do Unary RPCs in other number domains...")
+
+	sqrtRes, err := c.SquareRoot(context.Background(), &calculatorpb.SquareRootRequest{
+		Number:       -4,
+		NumberDomain: calculatorpb.NumberDomain_NUMBER_DOMAIN_COMPLEX,
+	})
+	if err != nil {
+		log.Fatalf("error while calling SquareRoot RPC: %v", err)
+	}
+	fmt.Printf("Square root of -4: %v\n", sqrtRes.GetText())
+
+	for _, req := range []*calculatorpb.EvaluateRequest{
+		{Expression: "1/3 + 1/6", NumberDomain: calculatorpb.NumberDomain_NUMBER_DOMAIN_RATIONAL},
+		{Expression: "(1+2i)*(3-4i)", NumberDomain: calculatorpb.NumberDomain_NUMBER_DOMAIN_COMPLEX},
+		{Expression: "7/2", NumberDomain: calculatorpb.NumberDomain_NUMBER_DOMAIN_INTEGER},
+	} {
+		res, err := c.Evaluate(context.Background(), req)
+		if err != nil {
+			respErr, ok := status.FromError(err)
+			if !ok {
+				log.Fatalf("error while calling Evaluate RPC: %v", err)
+			}
+			fmt.Printf("%v in the %v domain failed: %v\n", req.GetExpression(), req.GetNumberDomain(), respErr.Message())
+			continue
+		}
+		fmt.Printf("%v in the %v domain = %v\n", req.GetExpression(), req.GetNumberDomain(), res.GetText())
+	}
+}
+
+func doBatchEvaluate(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do a BatchEvaluate Server Streaming RPC...")
+
+	req := &calculatorpb.BatchEvaluateRequest{
+		Operations: []*calculatorpb.BatchOperation{
+			{Operation: &calculatorpb.BatchOperation_Sum{Sum: &calculatorpb.SumRequest{FirstNumber: 3, SecondNumber: 10}}},
+			{Operation: &calculatorpb.BatchOperation_PrimeNumberDecomposition{PrimeNumberDecomposition: &calculatorpb.PrimeNumberDecompositionRequest{
+				BigNumber: &calculatorpb.BigNumber{Value: "1000000016000000063"},
+			}}},
+			{Operation: &calculatorpb.BatchOperation_SquareRoot{SquareRoot: &calculatorpb.SquareRootRequest{Number: -1}}},
+			{Operation: &calculatorpb.BatchOperation_Evaluate{Evaluate: &calculatorpb.EvaluateRequest{Expression: "2 * (3 + sqrt(16)) ^ 2 % 7"}}},
+		},
+		Order:       calculatorpb.BatchOrder_BATCH_ORDER_COMPLETION,
+		Parallelism: 2,
+	}
+
+	resStream, err := c.BatchEvaluate(context.Background(), req)
+	if err != nil {
+		log.Fatalf("error while calling BatchEvaluate RPC: %v", err)
+	}
+	for {
+		res, err := resStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("error while reading stream: %v", err)
+		}
+		// each operation fails or succeeds on its own
+		if code := codes.Code(res.GetStatus().GetCode()); code != codes.OK {
+			fmt.Printf("Operation %d failed: %v %v\n", res.GetIndex(), code, res.GetStatus().GetMessage())
+			continue
+		}
+		fmt.Printf("Operation %d: %v\n", res.GetIndex(), res)
+	}
+}
+
+func doCache(c calculatorpb.CalculatorServiceClient, admin calculatorpb.CalculatorAdminServiceClient) {
+	fmt.Println("Starting to do cached Unary RPCs...")
+
+	// the second call is answered from the cache
+	req := &calculatorpb.IsPrimeRequest{Number: &calculatorpb.BigNumber{Value: "170141183460469231731687303715884105727"}}
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		res, err := c.IsPrime(context.Background(), req)
+		if err != nil {
+			log.Fatalf("error while calling IsPrime RPC: %v", err)
+		}
+		fmt.Printf("2^127 - 1 is prime: %v (in %v)\n", res.GetIsPrime(), time.Since(start))
+	}
+
+	stats, err := admin.GetCacheStats(context.Background(), &calculatorpb.GetCacheStatsRequest{})
+	if err != nil {
+		log.Fatalf("error while calling GetCacheStats RPC: %v", err)
+	}
+	fmt.Printf("Cache: %d hits, %d misses, %d entries of %d bytes\n", stats.GetHits(), stats.GetMisses(), stats.GetEntries(), stats.GetBytes())
+
+	flushRes, err := admin.FlushCache(context.Background(), &calculatorpb.FlushCacheRequest{})
+	if err != nil {
+		log.Fatalf("error while calling FlushCache RPC: %v", err)
+	}
+	fmt.Printf("Flushed %d entries\n", flushRes.GetFlushedEntries())
+}
+
+func doBuildHistogram(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do BuildHistogram Client Streaming RPC...")
+
+	stream, err := c.BuildHistogram(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while opening client stream: %v", err)
+	}
+
+	// latencies in milliseconds, in log scale bins from 1 ms to 10 s
+	stream.Send(&calculatorpb.BuildHistogramRequest{
+		Payload: &calculatorpb.BuildHistogramRequest_Config{Config: &calculatorpb.HistogramConfig{
+			Binning:  calculatorpb.HistogramBinning_HISTOGRAM_BINNING_LOG_SCALE,
+			BinCount: 4,
+			Lower:    1,
+			Upper:    10000,
+		}},
+	})
+	latencies := []float64{12, 15, 9, 230, 14, 18, 11, 1450, 16, 13, 20, 0.5}
+	for _, latency := range latencies {
+		stream.Send(&calculatorpb.BuildHistogramRequest{
+			Payload: &calculatorpb.BuildHistogramRequest_Number{Number: latency},
+		})
+	}
+
+	res, err := stream.CloseAndRecv()
+
+	if err != nil {
+		log.Fatalf("Error while receiving response: %v", err)
+	}
+
+	for _, bin := range res.GetBins() {
+		fmt.Printf("[%v, %v): %d\n", bin.GetLower(), bin.GetUpper(), bin.GetCount())
+	}
+	fmt.Printf("Below %v: %d\n", res.GetBins()[0].GetLower(), res.GetUnderflow())
+	for _, p := range res.GetPercentiles() {
+		fmt.Printf("p%v: %v\n", p.GetPercentile(), p.GetValue())
+	}
+}
+
+func doNumberNotation(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do FormatNumber and ParseNumber Unary RPCs...")
+
+	for _, req := range []*calculatorpb.FormatNumberRequest{
+		{Number: 255, Base: 16},
+		{Number: 1994, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN},
+		{Number: 112, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS},
+		{BigNumber: &calculatorpb.BigNumber{Value: "602214076000000000000000"}, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC},
+	} {
+		res, err := c.FormatNumber(context.Background(), req)
+		if err != nil {
+			log.Fatalf("error while calling FormatNumber RPC: %v", err)
+		}
+		number := fmt.Sprint(req.GetNumber())
+		if req.GetBigNumber() != nil {
+			number = req.GetBigNumber().GetValue()
+		}
+		fmt.Printf("%v in %v: %v\n", number, req.GetNotation(), res.GetText())
+	}
+
+	for _, req := range []*calculatorpb.ParseNumberRequest{
+		{Text: "one thousand and five", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS},
+		{Text: "MCMXCIIII", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN},
+	} {
+		res, err := c.ParseNumber(context.Background(), req)
+		if err != nil {
+			respErr, ok := status.FromError(err)
+			if !ok {
+				log.Fatalf("error while calling ParseNumber RPC: %v", err)
+			}
+			fmt.Printf("%q failed: %v\n", req.GetText(), respErr.Message())
+			continue
+		}
+		fmt.Printf("%q is %v\n", req.GetText(), res.GetBigNumber().GetValue())
+	}
+}
+
+func doRandomNumbers(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do RandomNumbers Server Streaming RPC...")
+
+	// the same seed streams the same numbers every time
+	req := &calculatorpb.RandomNumbersRequest{
+		Distribution:      calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_NORMAL,
+		Count:             10,
+		Mean:              100,
+		StandardDeviation: 15,
+		Integers:          true,
+		Source:            &calculatorpb.RandomNumbersRequest_Seed{Seed: 42},
+	}
+
+	resStream, err := c.RandomNumbers(context.Background(), req)
+	if err != nil {
+		log.Fatalf("error while calling RandomNumbers RPC: %v", err)
+	}
+	for {
+		res, err := resStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("error while reading stream: %v", err)
+		}
+		fmt.Printf("Normal integers of seed %v: %v\n", req.GetSeed(), res.GetIntegers())
+	}
+
+	// an endless stream of secure dice rolls, until the client has enough
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	diceStream, err := c.RandomNumbers(ctx, &calculatorpb.RandomNumbersRequest{
+		Integers:  true,
+		Min:       1,
+		Max:       6,
+		Source:    &calculatorpb.RandomNumbersRequest_Crypto{Crypto: true},
+		BatchSize: 8,
+	})
+	if err != nil {
+		log.Fatalf("error while calling RandomNumbers RPC: %v", err)
+	}
+	res, err := diceStream.Recv()
+	if err != nil {
+		log.Fatalf("error while reading stream: %v", err)
+	}
+	fmt.Printf("Secure dice rolls: %v\n", res.GetIntegers())
+}
+
+func doSymbolic(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do Simplify and Differentiate Unary RPCs...")
 
-	// doServerStreaming(c)
+	for _, expression := range []string{"x*y/x + 2*3 - 0*z", "x + x + 1/3 + 1/6", "(2*x)^2 * x^-1"} {
+		res, err := c.Simplify(context.Background(), &calculatorpb.SimplifyRequest{Expression: expression})
+		if err != nil {
+			log.Fatalf("error while calling Simplify RPC: %v", err)
+		}
+		fmt.Printf("%v simplifies to %v\n", expression, res.GetResult())
+	}
 
-	// doClientStreaming(c)
+	for _, expression := range []string{"x^3 - 2*x - 5", "sin(x)^2", "x^x", "ln(x)/x", "floor(x)"} {
+		res, err := c.Differentiate(context.Background(), &calculatorpb.DifferentiateRequest{Expression: expression})
+		if err != nil {
+			respErr, ok := status.FromError(err)
+			if ok && respErr.Code() == codes.InvalidArgument {
+				fmt.Printf("d/dx %v: %v\n", expression, respErr.Message())
+				continue
+			}
+			log.Fatalf("error while calling Differentiate RPC: %v", err)
+		}
+		fmt.Printf("d/dx %v = %v\n", expression, res.GetDerivative())
+	}
 
-	// doBiDiStreaming(c)
+	res, err := c.Differentiate(context.Background(), &calculatorpb.DifferentiateRequest{Expression: "x^2*y + y^2", Variable: "y"})
+	if err != nil {
+		log.Fatalf("error while calling Differentiate RPC: %v", err)
+	}
+	fmt.Printf("d/dy x^2*y + y^2 = %v, as a tree: %v\n", res.GetDerivative(), res.GetTree())
+}
+
+func doIntegerArithmetic(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do IntegerArithmetic Unary RPCs...")
+
+	requests := []*calculatorpb.IntegerArithmeticRequest{
+		{Operation: calculatorpb.IntegerOperation_INTEGER_OPERATION_MULTIPLY, FirstNumber: 3037000499, SecondNumber: 3037000499},
+		{Operation: calculatorpb.IntegerOperation_INTEGER_OPERATION_ADD, FirstNumber: math.MaxInt32, SecondNumber: 1, Type: calculatorpb.IntegerType_INTEGER_TYPE_INT32},
+		{Operation: calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, FirstNumber: -2, SecondNumber: 63},
+		{Operation: calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, FirstNumber: 2, SecondNumber: 63},
+		{Operation: calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE, FirstNumber: math.MinInt64, SecondNumber: -1},
+		{Operation: calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE, FirstNumber: 42, SecondNumber: 0},
+	}
+	for _, req := range requests {
+		res, err := c.IntegerArithmetic(context.Background(), req)
+		if err == nil {
+			fmt.Printf("%v %v %v = %v\n", req.GetOperation(), req.GetFirstNumber(), req.GetSecondNumber(), res.GetResult())
+			continue
+		}
+		respErr, ok := status.FromError(err)
+		if !ok {
+			log.Fatalf("error while calling IntegerArithmetic RPC: %v", err)
+		}
+		fmt.Printf("%v: %v\n", respErr.Code(), respErr.Message())
+		for _, detail := range respErr.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				fmt.Printf("    %v: bounds [%v, %v], exact result %q\n", info.GetReason(), info.GetMetadata()["min"], info.GetMetadata()["max"], info.GetMetadata()["result"])
+			}
+		}
+	}
+}
+
+func doCalculus(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do Integrate and FindRoot Unary RPCs...")
+
+	// fail rather than wait if the server cannot finish in time
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	intRes, err := c.Integrate(ctx, &calculatorpb.IntegrateRequest{
+		Expression: "exp(-x^2)",
+		Lower:      -10,
+		Upper:      10,
+	})
+	if err != nil {
+		log.Fatalf("error while calling Integrate RPC: %v", err)
+	}
+	fmt.Printf("Integral of exp(-x^2) over [-10, 10]: %v (error estimate %v, %d iterations)\n", intRes.GetResult(), intRes.GetErrorEstimate(), intRes.GetIterations())
+
+	rootRes, err := c.FindRoot(ctx, &calculatorpb.FindRootRequest{
+		Expression: "x^3 - 2*x - 5",
+		Lower:      2,
+		Upper:      3,
+	})
+	if err != nil {
+		log.Fatalf("error while calling FindRoot RPC: %v", err)
+	}
+	fmt.Printf("Root of x^3 - 2*x - 5 in [2, 3]: %v (error estimate %v, %d iterations)\n", rootRes.GetRoot(), rootRes.GetErrorEstimate(), rootRes.GetIterations())
+}
+
+func doFitLinearRegression(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do FitLinearRegression Client Streaming RPC...")
+
+	stream, err := c.FitLinearRegression(context.Background())
+
+	if err != nil {
+		log.Fatalf("Error while opening client stream: %v", err)
+	}
 
-	doErrorUnary(c)
+	points := [][2]float64{{1, 2.2}, {2, 4.1}, {3, 6.3}, {4, 7.9}, {5, 10.2}}
+
+	for _, point := range points {
+		fmt.Printf("Sending point: %v\n", point)
+		stream.Send(&calculatorpb.FitLinearRegressionRequest{
+			X: point[0],
+			Y: point[1],
+		})
+	}
+
+	res, err := stream.CloseAndRecv()
+
+	if err != nil {
+		log.Fatalf("Error while receiving response: %v", err)
+	}
+
+	fmt.Printf("y = %v * x + %v (r^2: %v)\n", res.GetSlope(), res.GetIntercept(), res.GetRSquared())
+}
+
+func doMatrix(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do matrix RPCs...")
+
+	res, err := c.ComputeMatrix(context.Background(), &calculatorpb.ComputeMatrixRequest{
+		Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_INVERSE,
+		First: &calculatorpb.Matrix{
+			Rows: []*calculatorpb.Vector{
+				{Values: []float64{2, 1}},
+				{Values: []float64{1, 3}},
+			},
+		},
+	})
+	if err != nil {
+		log.Fatalf("error while calling ComputeMatrix RPC: %v", err)
+	}
+	fmt.Printf("Inverse of [[2 1] [1 3]]: %v\n", res.GetMatrix())
+
+	// solve a system too large for one message, one row at a time
+	stream, err := c.UploadMatrix(context.Background())
+	if err != nil {
+		log.Fatalf("Error while opening client stream: %v", err)
+	}
+
+	n := 500
+	stream.Send(&calculatorpb.UploadMatrixRequest{
+		Payload: &calculatorpb.UploadMatrixRequest_Operation{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE},
+	})
+	vector := make([]float64, n)
+	for i := 0; i < n; i++ {
+		// a diagonally dominant matrix, so the system has a solution
+		row := make([]float64, n)
+		for j := range row {
+			row[j] = 1
+		}
+		row[i] = float64(n)
+		stream.Send(&calculatorpb.UploadMatrixRequest{
+			Payload: &calculatorpb.UploadMatrixRequest_FirstRow{FirstRow: &calculatorpb.Vector{Values: row}},
+		})
+		vector[i] = float64(2*n - 1)
+	}
+	stream.Send(&calculatorpb.UploadMatrixRequest{
+		Payload: &calculatorpb.UploadMatrixRequest_Vector{Vector: &calculatorpb.Vector{Values: vector}},
+	})
+
+	solved, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatalf("Error while receiving response: %v", err)
+	}
+	fmt.Printf("Solved a %dx%d system, the first values are %v\n", n, n, solved.GetVector().GetValues()[:5])
+}
+
+func doNumberTheory(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do number theory Unary RPCs...")
+
+	gcdRes, err := c.Gcd(context.Background(), &calculatorpb.GcdRequest{
+		FirstNumber:  &calculatorpb.BigNumber{Value: "1071"},
+		SecondNumber: &calculatorpb.BigNumber{Value: "462"},
+	})
+	if err != nil {
+		log.Fatalf("error while calling Gcd RPC: %v", err)
+	}
+	fmt.Printf("gcd(1071, 462) = %v\n", gcdRes.GetResult().GetValue())
+
+	powRes, err := c.ModPow(context.Background(), &calculatorpb.ModPowRequest{
+		Base:     &calculatorpb.BigNumber{Value: "4"},
+		Exponent: &calculatorpb.BigNumber{Value: "13"},
+		Modulus:  &calculatorpb.BigNumber{Value: "497"},
+	})
+	if err != nil {
+		log.Fatalf("error while calling ModPow RPC: %v", err)
+	}
+	fmt.Printf("4^13 mod 497 = %v\n", powRes.GetResult().GetValue())
+
+	primeRes, err := c.IsPrime(context.Background(), &calculatorpb.IsPrimeRequest{
+		Number: &calculatorpb.BigNumber{Value: "170141183460469231731687303715884105727"},
+	})
+	if err != nil {
+		log.Fatalf("error while calling IsPrime RPC: %v", err)
+	}
+	fmt.Printf("2^127 - 1 is prime: %v (proven: %v)\n", primeRes.GetIsPrime(), primeRes.GetProven())
+
+	// 6 and 9 are not coprime, so the inverse does not exist
+	_, err = c.ModInverse(context.Background(), &calculatorpb.ModInverseRequest{
+		Number:  &calculatorpb.BigNumber{Value: "6"},
+		Modulus: &calculatorpb.BigNumber{Value: "9"},
+	})
+	if err != nil {
+		respErr, ok := status.FromError(err)
+		if !ok {
+			log.Fatalf("error while calling ModInverse RPC: %v", err)
+		}
+		fmt.Printf("ModInverse(6, 9) failed: %v %v\n", respErr.Code(), respErr.Message())
+	}
+}
+
+func doBigNumbers(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do arbitrary-precision Unary RPCs...")
+
+	sumRes, err := c.BigSum(context.Background(), &calculatorpb.BigSumRequest{
+		FirstNumber:  &calculatorpb.BigNumber{Value: "2147483647"},
+		SecondNumber: &calculatorpb.BigNumber{Value: "1"},
+	})
+	if err != nil {
+		log.Fatalf("error while calling BigSum RPC: %v", err)
+	}
+	fmt.Printf("2147483647 + 1 = %v\n", sumRes.GetSumResult().GetValue())
+
+	divRes, err := c.BigArithmetic(context.Background(), &calculatorpb.BigArithmeticRequest{
+		Operation:    calculatorpb.BigOperation_BIG_OPERATION_ADD,
+		FirstNumber:  &calculatorpb.BigNumber{Value: "1/3"},
+		SecondNumber: &calculatorpb.BigNumber{Value: "1/6"},
+	})
+	if err != nil {
+		log.Fatalf("error while calling BigArithmetic RPC: %v", err)
+	}
+	fmt.Printf("1/3 + 1/6 = %v\n", divRes.GetResult().GetValue())
+
+	rootRes, err := c.BigSquareRoot(context.Background(), &calculatorpb.BigSquareRootRequest{
+		Number: &calculatorpb.BigNumber{Value: "2"},
+		Digits: 50,
+	})
+	if err != nil {
+		log.Fatalf("error while calling BigSquareRoot RPC: %v", err)
+	}
+	fmt.Printf("sqrt(2) = %v (exact: %v)\n", rootRes.GetNumberRoot().GetValue(), rootRes.GetExact())
+}
+
+func doEvaluate(c calculatorpb.CalculatorServiceClient) {
+	fmt.Println("Starting to do Evaluate Unary RPC...")
+
+	expressions := []string{
+		"2 * (3 + sqrt(16)) ^ 2 % 7",
+		"-2^2 + max(1, 5, 3) / 2",
+		"sin(pi / 2) + log(e)",
+		"3 * (4 + ",
+	}
+
+	for _, expression := range expressions {
+		res, err := c.Evaluate(context.Background(), &calculatorpb.EvaluateRequest{
+			Expression: expression,
+		})
+		if err != nil {
+			respErr, ok := status.FromError(err)
+			if ok && respErr.Code() == codes.InvalidArgument {
+				fmt.Printf("%v => %v\n", expression, respErr.Message())
+				continue
+			}
+			log.Fatalf("error while calling Evaluate RPC: %v", err)
+		}
+		fmt.Printf("%v = %v\n", expression, res.GetResult())
+	}
 }
 
 func doErrorUnary(c calculatorpb.CalculatorServiceClient) {
@@ -151,7 +867,8 @@ func doServerStreaming(c calculatorpb.CalculatorServiceClient) {
 	fmt.Println("Starting to do Server Streaming RPC...")
 
 	req := &calculatorpb.PrimeNumberDecompositionRequest{
-		Number: 12,
+		Number:       12,
+		GroupFactors: true,
 	}
 
 	streamRes, err := c.PrimeNumberDecomposition(context.Background(), req)
@@ -160,7 +877,7 @@ func doServerStreaming(c calculatorpb.CalculatorServiceClient) {
 		log.Fatalf("error while calling GreetManyTimes RPC: %v", err)
 	}
 
-	results := []int32{}
+	results := []string{}
 
 	for {
 		msg, err := streamRes.Recv()
@@ -173,7 +890,7 @@ func doServerStreaming(c calculatorpb.CalculatorServiceClient) {
 			log.Fatalf("error while calling GreetManyTimes RPC: %v", err)
 		}
 
-		results = append(results, msg.GetPrimeNumberDecomposition())
+		results = append(results, fmt.Sprintf("%v^%d", msg.GetPrime().GetValue(), msg.GetMultiplicity()))
 	}
 
 	fmt.Printf("Result: %v\n", results)
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxBatchOperations  = 10000
+	maxBatchParallelism = 64
+)
+
+// batchResult is a finished operation waiting to be streamed
+type batchResult struct {
+	index int
+	res   *calculatorpb.BatchEvaluateResponse
+}
+
+// runOperation runs one operation of a batch through the handler of its RPC,
+// so it is validated, computed and cached exactly as a single call would be
+func (s *server) runOperation(ctx context.Context, op *calculatorpb.BatchOperation) (*calculatorpb.BatchEvaluateResponse, error) {
+	switch op := op.GetOperation().(type) {
+	case *calculatorpb.BatchOperation_Sum:
+		res, err := memoized(ctx, "Sum", op.Sum, s.Sum)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_Sum{Sum: res}}, err
+	case *calculatorpb.BatchOperation_SquareRoot:
+		res, err := memoized(ctx, "SquareRoot", op.SquareRoot, s.SquareRoot)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_SquareRoot{SquareRoot: res}}, err
+	case *calculatorpb.BatchOperation_Evaluate:
+		res, err := memoized(ctx, "Evaluate", op.Evaluate, s.Evaluate)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_Evaluate{Evaluate: res}}, err
+	case *calculatorpb.BatchOperation_PrimeNumberDecomposition:
+		res, err := decompose(ctx, op.PrimeNumberDecomposition)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_PrimeNumberDecomposition{PrimeNumberDecomposition: res}}, err
+	case *calculatorpb.BatchOperation_BigArithmetic:
+		res, err := memoized(ctx, "BigArithmetic", op.BigArithmetic, s.BigArithmetic)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_BigArithmetic{BigArithmetic: res}}, err
+	case *calculatorpb.BatchOperation_Gcd:
+		res, err := memoized(ctx, "Gcd", op.Gcd, s.Gcd)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_Gcd{Gcd: res}}, err
+	case *calculatorpb.BatchOperation_ModPow:
+		res, err := memoized(ctx, "ModPow", op.ModPow, s.ModPow)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_ModPow{ModPow: res}}, err
+	case *calculatorpb.BatchOperation_IsPrime:
+		res, err := memoized(ctx, "IsPrime", op.IsPrime, s.IsPrime)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_IsPrime{IsPrime: res}}, err
+	case *calculatorpb.BatchOperation_Convert:
+		res, err := memoized(ctx, "Convert", op.Convert, s.Convert)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_Convert{Convert: res}}, err
+	case *calculatorpb.BatchOperation_Integrate:
+		res, err := memoized(ctx, "Integrate", op.Integrate, s.Integrate)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_Integrate{Integrate: res}}, err
+	case *calculatorpb.BatchOperation_FindRoot:
+		res, err := memoized(ctx, "FindRoot", op.FindRoot, s.FindRoot)
+		return &calculatorpb.BatchEvaluateResponse{Result: &calculatorpb.BatchEvaluateResponse_FindRoot{FindRoot: res}}, err
+	}
+	return nil, invalidArgument("operation", fmt.Errorf("unsupported operation %T", op.GetOperation()))
+}
+
+// decompose collects every prime factor of a number, for batches where the
+// factors cannot be streamed one by one
+func decompose(ctx context.Context, in *calculatorpb.PrimeNumberDecompositionRequest) (*calculatorpb.PrimeFactors, error) {
+	number, err := decompositionNumber(in)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := results.do(ctx, primeFactorsKey(number), func(ctx context.Context) (proto.Message, error) {
+		factors := &calculatorpb.PrimeFactors{}
+		err := factorize(ctx, number, func(prime *big.Int, multiplicity uint32) error {
+			factors.Factors = append(factors.Factors, primeFactor(prime, multiplicity))
+			return nil
+		})
+		if err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		return factors, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if in.GetGroupFactors() {
+		return res.(*calculatorpb.PrimeFactors), nil
+	}
+	factors := &calculatorpb.PrimeFactors{}
+	for _, factor := range res.(*calculatorpb.PrimeFactors).GetFactors() {
+		factors.Factors = append(factors.Factors, factorMessages(factor, false)...)
+	}
+	return factors, nil
+}
+
+// evaluateOperation runs operation index and turns its error, if any, into
+// the status of the result
+func (s *server) evaluateOperation(ctx context.Context, index int, op *calculatorpb.BatchOperation) *calculatorpb.BatchEvaluateResponse {
+	res, err := s.runOperation(ctx, op)
+	if err != nil {
+		st := status.Convert(err)
+		res = &calculatorpb.BatchEvaluateResponse{
+			Status: &calculatorpb.BatchStatus{Code: int32(st.Code()), Message: st.Message()},
+		}
+	} else {
+		res.Status = &calculatorpb.BatchStatus{Code: int32(codes.OK)}
+	}
+	res.Index = uint32(index)
+	return res
+}
+
+func (s *server) BatchEvaluate(in *calculatorpb.BatchEvaluateRequest, stream calculatorpb.CalculatorService_BatchEvaluateServer) error {
+	fmt.Printf("Received BatchEvaluate RPC with %d operations\n", len(in.GetOperations()))
+
+	ops := in.GetOperations()
+	if len(ops) == 0 {
+		return invalidArgument("operations", fmt.Errorf("the batch is empty"))
+	}
+	if len(ops) > maxBatchOperations {
+		return invalidArgument("operations", fmt.Errorf("%d operations, a batch holds at most %d", len(ops), maxBatchOperations))
+	}
+	order := in.GetOrder()
+	if _, ok := calculatorpb.BatchOrder_name[int32(order)]; !ok {
+		return invalidArgument("order", fmt.Errorf("unsupported order %v", order))
+	}
+
+	workers := int(in.GetParallelism())
+	if workers == 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > maxBatchParallelism {
+		workers = maxBatchParallelism
+	}
+	if workers > len(ops) {
+		workers = len(ops)
+	}
+
+	// the workers stop taking operations when the stream ends early
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	jobs := make(chan int)
+	done := make(chan batchResult, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				done <- batchResult{index: i, res: s.evaluateOperation(ctx, i, ops[i])}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for i := range ops {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	// drain the workers before returning, whatever the outcome
+	defer func() {
+		cancel()
+		for range done {
+		}
+	}()
+
+	// results that finished ahead of an earlier operation wait here in input order
+	pending := make(map[int]*calculatorpb.BatchEvaluateResponse)
+	next := 0
+	for r := range done {
+		if order == calculatorpb.BatchOrder_BATCH_ORDER_COMPLETION {
+			if err := stream.Send(r.res); err != nil {
+				return err
+			}
+			continue
+		}
+
+		pending[r.index] = r.res
+		for res, ok := pending[next]; ok; res, ok = pending[next] {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			delete(pending, next)
+			next++
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
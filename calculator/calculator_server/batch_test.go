@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// batchStream collects what BatchEvaluate sends
+type batchStream struct {
+	grpc.ServerStream
+	sent []*calculatorpb.BatchEvaluateResponse
+}
+
+func (s *batchStream) Context() context.Context { return context.Background() }
+
+func (s *batchStream) Send(res *calculatorpb.BatchEvaluateResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+func sumOperation(a, b int32) *calculatorpb.BatchOperation {
+	return &calculatorpb.BatchOperation{
+		Operation: &calculatorpb.BatchOperation_Sum{Sum: &calculatorpb.SumRequest{FirstNumber: a, SecondNumber: b}},
+	}
+}
+
+// batchOperations has a failing operation after every two that succeed
+func batchOperations(n int) []*calculatorpb.BatchOperation {
+	var ops []*calculatorpb.BatchOperation
+	for i := 0; i < n; i++ {
+		if i%3 == 2 {
+			ops = append(ops, &calculatorpb.BatchOperation{
+				Operation: &calculatorpb.BatchOperation_SquareRoot{SquareRoot: &calculatorpb.SquareRootRequest{Number: -1}},
+			})
+			continue
+		}
+		ops = append(ops, sumOperation(int32(i), 1))
+	}
+	return ops
+}
+
+// checkBatchResult checks the result of operation i of batchOperations
+func checkBatchResult(t *testing.T, res *calculatorpb.BatchEvaluateResponse) {
+	t.Helper()
+	i := int(res.GetIndex())
+	if i%3 == 2 {
+		if codes.Code(res.GetStatus().GetCode()) != codes.InvalidArgument {
+			t.Errorf("operation %d: got status %v, want code %v", i, res.GetStatus(), codes.InvalidArgument)
+		}
+		return
+	}
+	if codes.Code(res.GetStatus().GetCode()) != codes.OK || res.GetSum().GetSumResult() != int32(i+1) {
+		t.Errorf("operation %d: got %v, want a sum of %d", i, res, i+1)
+	}
+}
+
+func TestBatchEvaluate(t *testing.T) {
+	for _, parallelism := range []uint32{0, 1, 4, 1000} {
+		for _, order := range []calculatorpb.BatchOrder{calculatorpb.BatchOrder_BATCH_ORDER_INPUT, calculatorpb.BatchOrder_BATCH_ORDER_COMPLETION} {
+			name := fmt.Sprintf("parallelism %d, order %v", parallelism, order)
+			in := &calculatorpb.BatchEvaluateRequest{Operations: batchOperations(100), Order: order, Parallelism: parallelism}
+			stream := &batchStream{}
+			if err := (&server{}).BatchEvaluate(in, stream); err != nil {
+				t.Fatalf("%v: BatchEvaluate failed: %v", name, err)
+			}
+			if len(stream.sent) != len(in.GetOperations()) {
+				t.Fatalf("%v: got %d results, want %d", name, len(stream.sent), len(in.GetOperations()))
+			}
+
+			var indexes []int
+			for _, res := range stream.sent {
+				checkBatchResult(t, res)
+				indexes = append(indexes, int(res.GetIndex()))
+			}
+			inOrder := sort.IntsAreSorted(indexes)
+			sort.Ints(indexes)
+			for i, index := range indexes {
+				if index != i {
+					t.Fatalf("%v: the results have indexes %v, want each operation once", name, indexes)
+				}
+			}
+			if order == calculatorpb.BatchOrder_BATCH_ORDER_INPUT && !inOrder {
+				t.Errorf("%v: the results are not in input order", name)
+			}
+		}
+	}
+}
+
+func TestBatchEvaluateErrors(t *testing.T) {
+	tests := []*calculatorpb.BatchEvaluateRequest{
+		{},
+		{Operations: batchOperations(maxBatchOperations + 1)},
+		{Operations: batchOperations(1), Order: calculatorpb.BatchOrder(99)},
+	}
+	for _, in := range tests {
+		stream := &batchStream{}
+		if err := (&server{}).BatchEvaluate(in, stream); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("BatchEvaluate with %d operations, order %v: got %v, want code %v", len(in.GetOperations()), in.GetOrder(), err, codes.InvalidArgument)
+		}
+		if len(stream.sent) != 0 {
+			t.Errorf("BatchEvaluate with %d operations, order %v sent %d results", len(in.GetOperations()), in.GetOrder(), len(stream.sent))
+		}
+	}
+}
+
+func TestBatchEvaluateEmptyOperation(t *testing.T) {
+	in := &calculatorpb.BatchEvaluateRequest{Operations: []*calculatorpb.BatchOperation{{}}}
+	stream := &batchStream{}
+	if err := (&server{}).BatchEvaluate(in, stream); err != nil {
+		t.Fatalf("BatchEvaluate failed: %v", err)
+	}
+	if len(stream.sent) != 1 || codes.Code(stream.sent[0].GetStatus().GetCode()) != codes.InvalidArgument {
+		t.Errorf("BatchEvaluate of an empty operation sent %v, want one %v result", stream.sent, codes.InvalidArgument)
+	}
+}
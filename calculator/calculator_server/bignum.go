@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// Limits that keep a single request from exhausting the server
+const (
+	maxBigNumberLength  = 10000   // characters in a BigNumber value
+	maxBigExponent      = 10000   // absolute value of a decimal exponent such as 1e100
+	maxBigResultBits    = 1 << 22 // bits of a power's numerator or denominator, about 1.26M digits
+	maxSquareRootDigits = 10000   // digits after the decimal point of a square root
+)
+
+// parseBigNumber reads an integer, fraction or decimal into an exact rational
+func parseBigNumber(n *calculatorpb.BigNumber) (*big.Rat, error) {
+	return parseBigNumberWithin(n, maxBigNumberLength, maxBigExponent)
+}
+
+// parseBigNumberWithin is parseBigNumber with other bounds on the length of
+// the value and on its decimal exponent
+func parseBigNumberWithin(n *calculatorpb.BigNumber, maxLength, maxExponent int) (*big.Rat, error) {
+	s := strings.TrimSpace(n.GetValue())
+	if s == "" {
+		return nil, errors.New("number is empty")
+	}
+	if len(s) > maxLength {
+		return nil, fmt.Errorf("number has more than %d characters", maxLength)
+	}
+	// big.Rat accepts exponents of any size, which could take forever to expand
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%q has an invalid exponent", s)
+		}
+		if exp > maxExponent || exp < -maxExponent {
+			return nil, fmt.Errorf("exponent of %q is out of range [%d, %d]", s, -maxExponent, maxExponent)
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not an integer, fraction or decimal number", s)
+	}
+	return r, nil
+}
+
+// formatBigNumber writes r as an integer or a fraction in lowest terms
+func formatBigNumber(r *big.Rat) *calculatorpb.BigNumber {
+	return &calculatorpb.BigNumber{Value: r.RatString()}
+}
+
+// operandError is an error of bigArithmetic caused by one of its operands
+type operandError struct {
+	field string // of the BigArithmeticRequest
+	err   error
+}
+
+func (e operandError) Error() string {
+	return e.err.Error()
+}
+
+func bigArithmetic(op calculatorpb.BigOperation, x, y *big.Rat) (*big.Rat, error) {
+	switch op {
+	case calculatorpb.BigOperation_BIG_OPERATION_ADD:
+		return new(big.Rat).Add(x, y), nil
+	case calculatorpb.BigOperation_BIG_OPERATION_SUBTRACT:
+		return new(big.Rat).Sub(x, y), nil
+	case calculatorpb.BigOperation_BIG_OPERATION_MULTIPLY:
+		return new(big.Rat).Mul(x, y), nil
+	case calculatorpb.BigOperation_BIG_OPERATION_DIVIDE:
+		if y.Sign() == 0 {
+			return nil, operandError{"second_number", errors.New("division by zero")}
+		}
+		return new(big.Rat).Quo(x, y), nil
+	case calculatorpb.BigOperation_BIG_OPERATION_POWER:
+		return bigPower(x, y)
+	}
+	return nil, operandError{"operation", fmt.Errorf("unsupported operation %v", op)}
+}
+
+// bigPower raises x to the integer power y
+func bigPower(x, y *big.Rat) (*big.Rat, error) {
+	if !y.IsInt() {
+		return nil, operandError{"second_number", fmt.Errorf("exponent %v is not an integer", y.RatString())}
+	}
+	exp := y.Num()
+	if x.Sign() == 0 {
+		if exp.Sign() < 0 {
+			return nil, operandError{"first_number", errors.New("zero cannot be raised to a negative power")}
+		}
+		if exp.Sign() == 0 {
+			return big.NewRat(1, 1), nil
+		}
+		return new(big.Rat), nil
+	}
+	// |x| = 1 stays small whatever the exponent
+	if x.Num().CmpAbs(x.Denom()) == 0 {
+		if x.Sign() < 0 && exp.Bit(0) == 1 {
+			return big.NewRat(-1, 1), nil
+		}
+		return big.NewRat(1, 1), nil
+	}
+
+	bits := x.Num().BitLen()
+	if d := x.Denom().BitLen(); d > bits {
+		bits = d
+	}
+	absExp := new(big.Int).Abs(exp)
+	if !absExp.IsInt64() || absExp.Int64() > int64(maxBigResultBits/bits) {
+		return nil, operandError{"second_number", fmt.Errorf("result would have more than %d bits", maxBigResultBits)}
+	}
+
+	num := new(big.Int).Exp(x.Num(), absExp, nil)
+	den := new(big.Int).Exp(x.Denom(), absExp, nil)
+	if exp.Sign() < 0 {
+		num, den = den, num
+	}
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+// bigSquareRoot returns the square root of x, which must not be negative.
+// Roots of rationals whose numerator and denominator are perfect squares are
+// returned exactly; the others are truncated to digits decimal places.
+func bigSquareRoot(x *big.Rat, digits uint32) (root string, exact bool) {
+	num, den := x.Num(), x.Denom()
+	numRoot := new(big.Int).Sqrt(num)
+	denRoot := new(big.Int).Sqrt(den)
+	if new(big.Int).Mul(numRoot, numRoot).Cmp(num) == 0 && new(big.Int).Mul(denRoot, denRoot).Cmp(den) == 0 {
+		return new(big.Rat).SetFrac(numRoot, denRoot).RatString(), true
+	}
+
+	// floor(sqrt(num/den * 10^(2*digits))) has the digits we want
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(2*int64(digits)), nil)
+	scaled := new(big.Int).Mul(num, scale)
+	scaled.Quo(scaled, den)
+	return insertDecimalPoint(new(big.Int).Sqrt(scaled).String(), int(digits)), false
+}
+
+// insertDecimalPoint writes the non-negative integer digits s divided by 10^places
+func insertDecimalPoint(s string, places int) string {
+	if places == 0 {
+		return s
+	}
+	if len(s) <= places {
+		s = strings.Repeat("0", places-len(s)+1) + s
+	}
+	return s[:len(s)-places] + "." + s[len(s)-places:]
+}
+
+func (*server) BigSum(ctx context.Context, in *calculatorpb.BigSumRequest) (*calculatorpb.BigSumResponse, error) {
+	fmt.Printf("Received BigSum RPC: %v\n", in)
+
+	first, err := parseBigNumber(in.GetFirstNumber())
+	if err != nil {
+		return nil, invalidArgument("first_number", err)
+	}
+	second, err := parseBigNumber(in.GetSecondNumber())
+	if err != nil {
+		return nil, invalidArgument("second_number", err)
+	}
+
+	return &calculatorpb.BigSumResponse{
+		SumResult: formatBigNumber(new(big.Rat).Add(first, second)),
+	}, nil
+}
+
+func (*server) BigArithmetic(ctx context.Context, in *calculatorpb.BigArithmeticRequest) (*calculatorpb.BigArithmeticResponse, error) {
+	fmt.Printf("Received BigArithmetic RPC: %v\n", in)
+
+	if _, ok := calculatorpb.BigOperation_name[int32(in.GetOperation())]; !ok || in.GetOperation() == calculatorpb.BigOperation_BIG_OPERATION_UNSPECIFIED {
+		return nil, invalidArgument("operation", fmt.Errorf("unsupported operation %v", in.GetOperation()))
+	}
+	first, err := parseBigNumber(in.GetFirstNumber())
+	if err != nil {
+		return nil, invalidArgument("first_number", err)
+	}
+	second, err := parseBigNumber(in.GetSecondNumber())
+	if err != nil {
+		return nil, invalidArgument("second_number", err)
+	}
+
+	result, err := bigArithmetic(in.GetOperation(), first, second)
+	if err != nil {
+		field := "second_number"
+		if operandErr, ok := err.(operandError); ok {
+			field = operandErr.field
+		}
+		return nil, invalidArgument(field, err)
+	}
+
+	return &calculatorpb.BigArithmeticResponse{
+		Result: formatBigNumber(result),
+	}, nil
+}
+
+func (*server) BigSquareRoot(ctx context.Context, in *calculatorpb.BigSquareRootRequest) (*calculatorpb.BigSquareRootResponse, error) {
+	fmt.Printf("Received BigSquareRoot RPC: %v\n", in)
+
+	number, err := parseBigNumber(in.GetNumber())
+	if err != nil {
+		return nil, invalidArgument("number", err)
+	}
+	if number.Sign() < 0 {
+		return nil, invalidArgument("number", fmt.Errorf("received a negative number: %v", number.RatString()))
+	}
+	if in.GetDigits() > maxSquareRootDigits {
+		return nil, invalidArgument("digits", fmt.Errorf("at most %d digits are supported", maxSquareRootDigits))
+	}
+
+	root, exact := bigSquareRoot(number, in.GetDigits())
+	return &calculatorpb.BigSquareRootResponse{
+		NumberRoot: &calculatorpb.BigNumber{Value: root},
+		Exact:      exact,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+func TestBigSum(t *testing.T) {
+	in := &calculatorpb.BigSumRequest{
+		FirstNumber:  &calculatorpb.BigNumber{Value: "123456789012345678901234567890"},
+		SecondNumber: &calculatorpb.BigNumber{Value: "0.1"},
+	}
+	res, err := (&server{}).BigSum(context.Background(), in)
+	if err != nil {
+		t.Fatalf("BigSum(%v) failed: %v", in, err)
+	}
+	if got, want := res.GetSumResult().GetValue(), "1234567890123456789012345678901/10"; got != want {
+		t.Errorf("BigSum(%v) = %v, want %v", in, got, want)
+	}
+}
+
+func TestBigArithmetic(t *testing.T) {
+	tests := []struct {
+		op   calculatorpb.BigOperation
+		a, b string
+		want string
+	}{
+		{calculatorpb.BigOperation_BIG_OPERATION_ADD, "1/3", "1/6", "1/2"},
+		{calculatorpb.BigOperation_BIG_OPERATION_SUBTRACT, "1", "99999999999999999999", "-99999999999999999998"},
+		{calculatorpb.BigOperation_BIG_OPERATION_MULTIPLY, "1e20", "-2.5", "-250000000000000000000"},
+		{calculatorpb.BigOperation_BIG_OPERATION_DIVIDE, "1", "3", "1/3"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "2", "100", "1267650600228229401496703205376"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "2/3", "-2", "9/4"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "-1", "1000000000001", "-1"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "0", "0", "1"},
+	}
+	for _, tt := range tests {
+		in := &calculatorpb.BigArithmeticRequest{
+			Operation:    tt.op,
+			FirstNumber:  &calculatorpb.BigNumber{Value: tt.a},
+			SecondNumber: &calculatorpb.BigNumber{Value: tt.b},
+		}
+		res, err := (&server{}).BigArithmetic(context.Background(), in)
+		if err != nil {
+			t.Errorf("BigArithmetic(%v) failed: %v", in, err)
+			continue
+		}
+		if got := res.GetResult().GetValue(); got != tt.want {
+			t.Errorf("BigArithmetic(%v) = %v, want %v", in, got, tt.want)
+		}
+	}
+}
+
+func TestBigArithmeticErrors(t *testing.T) {
+	tests := []struct {
+		op    calculatorpb.BigOperation
+		a, b  string
+		field string
+	}{
+		{calculatorpb.BigOperation_BIG_OPERATION_UNSPECIFIED, "1", "2", "operation"},
+		{calculatorpb.BigOperation(99), "1", "2", "operation"},
+		{calculatorpb.BigOperation_BIG_OPERATION_ADD, "one", "2", "first_number"},
+		{calculatorpb.BigOperation_BIG_OPERATION_ADD, "1", "1e100000", "second_number"},
+		{calculatorpb.BigOperation_BIG_OPERATION_DIVIDE, "1", "0", "second_number"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "2", "1/2", "second_number"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "0", "-1", "first_number"},
+		{calculatorpb.BigOperation_BIG_OPERATION_POWER, "3", "100000000", "second_number"},
+	}
+	for _, tt := range tests {
+		in := &calculatorpb.BigArithmeticRequest{
+			Operation:    tt.op,
+			FirstNumber:  &calculatorpb.BigNumber{Value: tt.a},
+			SecondNumber: &calculatorpb.BigNumber{Value: tt.b},
+		}
+		_, err := (&server{}).BigArithmetic(context.Background(), in)
+		st := status.Convert(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Errorf("BigArithmetic(%v): got %v, want code %v", in, err, codes.InvalidArgument)
+			continue
+		}
+		var field string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.GetFieldViolations()) > 0 {
+				field = badRequest.GetFieldViolations()[0].GetField()
+			}
+		}
+		if field != tt.field {
+			t.Errorf("BigArithmetic(%v): error is about %q, want %q", in, field, tt.field)
+		}
+	}
+}
+
+func TestBigSquareRoot(t *testing.T) {
+	tests := []struct {
+		number string
+		digits uint32
+		want   string
+		exact  bool
+	}{
+		{"4/9", 5, "2/3", true},
+		{"152415787532388367501905199875019052100", 0, "12345678901234567890", true},
+		{"2", 10, "1.4142135623", false},
+		{"2", 0, "1", false},
+		{"1/10000", 3, "1/100", true},
+		{"1/1000", 3, "0.031", false},
+	}
+	for _, tt := range tests {
+		in := &calculatorpb.BigSquareRootRequest{Number: &calculatorpb.BigNumber{Value: tt.number}, Digits: tt.digits}
+		res, err := (&server{}).BigSquareRoot(context.Background(), in)
+		if err != nil {
+			t.Errorf("BigSquareRoot(%v) failed: %v", in, err)
+			continue
+		}
+		if got := res.GetNumberRoot().GetValue(); got != tt.want || res.GetExact() != tt.exact {
+			t.Errorf("BigSquareRoot(%v) = %v (exact %v), want %v (exact %v)", in, got, res.GetExact(), tt.want, tt.exact)
+		}
+	}
+
+	for _, in := range []*calculatorpb.BigSquareRootRequest{
+		{Number: &calculatorpb.BigNumber{Value: "-4"}},
+		{Number: &calculatorpb.BigNumber{Value: "2"}, Digits: maxSquareRootDigits + 1},
+		{Number: &calculatorpb.BigNumber{Value: "1e10001"}},
+	} {
+		if _, err := (&server{}).BigSquareRoot(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("BigSquareRoot(%v): got %v, want code %v", in, err, codes.InvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// results is the cache shared by the calculator RPCs, set up in main.
+// A nil cache computes every request.
+var results *resultCache
+
+// cachedMethods are the unary RPCs whose response depends on nothing but the
+// request, so it can be cached
+var cachedMethods = map[string]bool{
+	serviceMethod("Sum"):               true,
+	serviceMethod("SquareRoot"):        true,
+	serviceMethod("Evaluate"):          true,
+	serviceMethod("Integrate"):         true,
+	serviceMethod("FindRoot"):          true,
+	serviceMethod("Simplify"):          true,
+	serviceMethod("Differentiate"):     true,
+	serviceMethod("Convert"):           true,
+	serviceMethod("EvaluateQuantity"):  true,
+	serviceMethod("BigSum"):            true,
+	serviceMethod("BigArithmetic"):     true,
+	serviceMethod("BigSquareRoot"):     true,
+	serviceMethod("IntegerArithmetic"): true,
+	serviceMethod("Gcd"):               true,
+	serviceMethod("Lcm"):               true,
+	serviceMethod("ModPow"):            true,
+	serviceMethod("ModInverse"):        true,
+	serviceMethod("IsPrime"):           true,
+	serviceMethod("FormatNumber"):      true,
+	serviceMethod("ParseNumber"):       true,
+	serviceMethod("ComputeMatrix"):     true,
+}
+
+// serviceMethod returns the full name of a CalculatorService method, as the
+// interceptors see it
+func serviceMethod(name string) string {
+	return "/" + calculatorpb.CalculatorService_ServiceDesc.ServiceName + "/" + name
+}
+
+// cacheKey identifies a request of method by its serialised fields
+func cacheKey(method string, req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	return method + "\x00" + string(b), nil
+}
+
+// primeFactorsKey identifies a decomposition by its number, however it was given
+func primeFactorsKey(number *big.Int) string {
+	return serviceMethod("PrimeNumberDecomposition") + "\x00" + number.String()
+}
+
+type cacheEntry struct {
+	key   string
+	value proto.Message
+	size  int64
+}
+
+// cacheCall is a computation in progress, which identical requests wait for
+type cacheCall struct {
+	ctx        context.Context // of the request computing the result
+	generation uint64
+	done       chan struct{}
+	value      proto.Message
+	err        error
+}
+
+// resultCache is a least recently used cache of responses bounded by their
+// size. Identical requests arriving while a result is being computed wait
+// for it instead of computing it again.
+type resultCache struct {
+	mu         sync.Mutex
+	maxBytes   int64
+	bytes      int64
+	lru        *list.List // of *cacheEntry, most recently used first
+	entries    map[string]*list.Element
+	calls      map[string]*cacheCall
+	generation uint64 // incremented by every flush
+
+	hits, misses, deduplicated, evictions uint64
+}
+
+func newResultCache(maxBytes int64) *resultCache {
+	return &resultCache{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		calls:    make(map[string]*cacheCall),
+	}
+}
+
+// do returns the cached result of key, or computes it with fn and caches it
+// when fn succeeds. Errors are shared with the requests that waited but are
+// never cached.
+func (c *resultCache) do(ctx context.Context, key string, fn func(ctx context.Context) (proto.Message, error)) (proto.Message, error) {
+	if c == nil {
+		return fn(ctx)
+	}
+
+	for {
+		c.mu.Lock()
+		if el, ok := c.entries[key]; ok {
+			c.lru.MoveToFront(el)
+			c.hits++
+			c.mu.Unlock()
+			return el.Value.(*cacheEntry).value, nil
+		}
+
+		if call, ok := c.calls[key]; ok {
+			c.deduplicated++
+			c.mu.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+			// the request computing the result gave up, compute it for this one
+			if call.err != nil && call.ctx.Err() != nil && ctx.Err() == nil {
+				continue
+			}
+			return call.value, call.err
+		}
+
+		call := &cacheCall{ctx: ctx, generation: c.generation, done: make(chan struct{})}
+		c.calls[key] = call
+		c.misses++
+		c.mu.Unlock()
+
+		call.value, call.err = fn(ctx)
+
+		c.mu.Lock()
+		delete(c.calls, key)
+		if call.err == nil && call.generation == c.generation {
+			c.add(key, call.value)
+		}
+		c.mu.Unlock()
+		close(call.done)
+		return call.value, call.err
+	}
+}
+
+// add stores value and evicts the least recently used entries beyond the
+// capacity. c.mu must be held.
+func (c *resultCache) add(key string, value proto.Message) {
+	size := int64(len(key) + proto.Size(value))
+	// one result may not push out most of the cache
+	if size > c.maxBytes/8 {
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: value, size: size})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		oldest := c.lru.Remove(c.lru.Back()).(*cacheEntry)
+		delete(c.entries, oldest.key)
+		c.bytes -= oldest.size
+		c.evictions++
+	}
+}
+
+func (c *resultCache) stats() *calculatorpb.CacheStats {
+	if c == nil {
+		return &calculatorpb.CacheStats{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &calculatorpb.CacheStats{
+		Hits:          c.hits,
+		Misses:        c.misses,
+		Deduplicated:  c.deduplicated,
+		Evictions:     c.evictions,
+		Entries:       uint64(len(c.entries)),
+		Bytes:         uint64(c.bytes),
+		CapacityBytes: uint64(c.maxBytes),
+	}
+}
+
+// flush drops every entry and returns how many there were and their size
+func (c *resultCache) flush() (entries, bytes uint64) {
+	if c == nil {
+		return 0, 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries, bytes = uint64(len(c.entries)), uint64(c.bytes)
+	c.lru.Init()
+	c.entries = make(map[string]*list.Element)
+	c.bytes = 0
+	c.generation++
+	return entries, bytes
+}
+
+// cacheInterceptor answers the cacheable unary RPCs from the results cache
+func cacheInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	msg, ok := req.(proto.Message)
+	if !ok || !cachedMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	key, err := cacheKey(info.FullMethod, msg)
+	if err != nil {
+		return handler(ctx, req)
+	}
+
+	return results.do(ctx, key, func(ctx context.Context) (proto.Message, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res.(proto.Message), nil
+	})
+}
+
+// memoized calls the handler of method through the results cache, for
+// requests that do not go through the interceptor
+func memoized[Req, Res proto.Message](ctx context.Context, method string, req Req, handler func(context.Context, Req) (Res, error)) (Res, error) {
+	var zero Res
+	key, err := cacheKey(serviceMethod(method), req)
+	if err != nil {
+		return handler(ctx, req)
+	}
+
+	res, err := results.do(ctx, key, func(ctx context.Context) (proto.Message, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+	if err != nil {
+		return zero, err
+	}
+	return res.(Res), nil
+}
+
+type adminServer struct{}
+
+func (*adminServer) GetCacheStats(ctx context.Context, in *calculatorpb.GetCacheStatsRequest) (*calculatorpb.CacheStats, error) {
+	fmt.Println("Received GetCacheStats RPC")
+
+	return results.stats(), nil
+}
+
+func (*adminServer) FlushCache(ctx context.Context, in *calculatorpb.FlushCacheRequest) (*calculatorpb.FlushCacheResponse, error) {
+	fmt.Println("Received FlushCache RPC")
+
+	entries, bytes := results.flush()
+	return &calculatorpb.FlushCacheResponse{
+		FlushedEntries: entries,
+		FlushedBytes:   bytes,
+	}, nil
+}
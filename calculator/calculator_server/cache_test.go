@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// computeSum is a computation for resultCache.do that counts its calls
+func computeSum(calls *int32, sum int32) func(context.Context) (proto.Message, error) {
+	return func(context.Context) (proto.Message, error) {
+		atomic.AddInt32(calls, 1)
+		return &calculatorpb.SumResponse{SumResult: sum}, nil
+	}
+}
+
+func TestResultCache(t *testing.T) {
+	c := newResultCache(1 << 20)
+	var calls int32
+	for i := 0; i < 3; i++ {
+		res, err := c.do(context.Background(), "a", computeSum(&calls, 1))
+		if err != nil {
+			t.Fatalf("do failed: %v", err)
+		}
+		if res.(*calculatorpb.SumResponse).GetSumResult() != 1 {
+			t.Errorf("do = %v, want a sum of 1", res)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("the result was computed %d times, want once", calls)
+	}
+
+	// errors are not cached
+	failures := 0
+	for i := 0; i < 2; i++ {
+		_, err := c.do(context.Background(), "b", func(context.Context) (proto.Message, error) {
+			failures++
+			return nil, errors.New("failed")
+		})
+		if err == nil {
+			t.Errorf("do succeeded, want the error of the computation")
+		}
+	}
+	if failures != 2 {
+		t.Errorf("the failing computation ran %d times, want 2", failures)
+	}
+
+	stats := c.stats()
+	if stats.GetHits() != 2 || stats.GetMisses() != 3 || stats.GetEntries() != 1 {
+		t.Errorf("stats = %v, want 2 hits, 3 misses and 1 entry", stats)
+	}
+
+	entries, bytes := c.flush()
+	if entries != 1 || bytes != stats.GetBytes() {
+		t.Errorf("flush = %d entries of %d bytes, want 1 of %d", entries, bytes, stats.GetBytes())
+	}
+	if _, err := c.do(context.Background(), "a", computeSum(&calls, 1)); err != nil || calls != 2 {
+		t.Errorf("do after a flush: %v, computed %d times, want twice", err, calls)
+	}
+}
+
+func TestResultCacheEviction(t *testing.T) {
+	c := newResultCache(128)
+	var calls int32
+	for i := 0; i < 100; i++ {
+		if _, err := c.do(context.Background(), fmt.Sprint(i), computeSum(&calls, int32(i))); err != nil {
+			t.Fatalf("do failed: %v", err)
+		}
+	}
+	stats := c.stats()
+	if stats.GetBytes() > 128 || stats.GetEvictions() == 0 || stats.GetEntries()+stats.GetEvictions() != 100 {
+		t.Errorf("stats = %v, want at most 128 bytes and the rest evicted", stats)
+	}
+
+	// the most recent entry is kept, the oldest is gone
+	before := calls
+	c.do(context.Background(), "99", computeSum(&calls, 99))
+	if calls != before {
+		t.Errorf("the most recent result was computed again")
+	}
+	c.do(context.Background(), "0", computeSum(&calls, 0))
+	if calls != before+1 {
+		t.Errorf("the oldest result was not evicted")
+	}
+}
+
+func TestResultCacheDeduplication(t *testing.T) {
+	c := newResultCache(1 << 20)
+	var calls int32
+	release := make(chan struct{})
+	slow := func(ctx context.Context) (proto.Message, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return &calculatorpb.SumResponse{SumResult: 3}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c.do(context.Background(), "slow", slow)
+			if err != nil || res.(*calculatorpb.SumResponse).GetSumResult() != 3 {
+				t.Errorf("do = %v, %v, want a sum of 3", res, err)
+			}
+		}()
+	}
+	// let every request find the computation in progress
+	for c.stats().GetDeduplicated() < 9 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("the result was computed %d times, want once", calls)
+	}
+}
+
+func TestResultCacheAbandonedComputation(t *testing.T) {
+	c := newResultCache(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := c.do(ctx, "k", func(ctx context.Context) (proto.Message, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		done <- err
+	}()
+	<-started
+
+	// a request waiting for a computation whose client went away computes it itself
+	waiting := make(chan proto.Message)
+	var calls int32
+	go func() {
+		res, _ := c.do(context.Background(), "k", computeSum(&calls, 7))
+		waiting <- res
+	}()
+	for c.stats().GetDeduplicated() < 1 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err == nil {
+		t.Errorf("the cancelled computation succeeded")
+	}
+	if res := <-waiting; res.(*calculatorpb.SumResponse).GetSumResult() != 7 || calls != 1 {
+		t.Errorf("the waiting request got %v after %d computations, want a sum of 7 after one", res, calls)
+	}
+}
+
+func TestNilResultCache(t *testing.T) {
+	var c *resultCache
+	var calls int32
+	for i := 0; i < 2; i++ {
+		if _, err := c.do(context.Background(), "a", computeSum(&calls, 1)); err != nil {
+			t.Fatalf("do failed: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("a nil cache computed the result %d times, want twice", calls)
+	}
+	if entries, _ := c.flush(); entries != 0 {
+		t.Errorf("flush of a nil cache = %d entries", entries)
+	}
+}
+
+func TestCacheInterceptor(t *testing.T) {
+	saved := results
+	results = newResultCache(1 << 20)
+	defer func() { results = saved }()
+
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return (&server{}).Sum(ctx, req.(*calculatorpb.SumRequest))
+	}
+	tests := []struct {
+		method string
+		calls  int // of the handler after two identical requests
+	}{
+		{serviceMethod("Sum"), 1},
+		{serviceMethod("Session"), 2},
+	}
+	for _, tt := range tests {
+		calls = 0
+		for i := 0; i < 2; i++ {
+			res, err := cacheInterceptor(context.Background(), &calculatorpb.SumRequest{FirstNumber: 3, SecondNumber: 4}, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if err != nil {
+				t.Fatalf("%v: cacheInterceptor failed: %v", tt.method, err)
+			}
+			if got := res.(*calculatorpb.SumResponse).GetSumResult(); got != 7 {
+				t.Errorf("%v: got %v, want 7", tt.method, got)
+			}
+		}
+		if calls != tt.calls {
+			t.Errorf("%v: the handler ran %d times, want %d", tt.method, calls, tt.calls)
+		}
+	}
+}
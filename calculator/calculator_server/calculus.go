@@ -0,0 +1,428 @@
+package main
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// Defaults and limits of Integrate and FindRoot
+const (
+	defaultIntegrateTolerance  = 1e-10
+	defaultIntegrateIterations = 1000
+	maxIntegrateIterations     = 100000
+
+	defaultRootTolerance  = 1e-12
+	defaultRootIterations = 100
+	maxRootIterations     = 10000
+)
+
+// realFunction is an expression of one variable, evaluated within a context
+type realFunction func(x float64) (float64, error)
+
+// compileFunction parses expression as a function of variable. Evaluating it
+// returns ctx.Err() once ctx is done, and errors naming the point otherwise.
+func compileFunction(ctx context.Context, expression, variable string) (realFunction, error) {
+	node, err := parseExpr(expression)
+	if err != nil {
+		return nil, err
+	}
+	vars := map[string]float64{}
+	e := &evaluator{vars: vars, ctx: ctx}
+	return func(x float64) (float64, error) {
+		vars[variable] = x
+		y, err := e.eval(node)
+		if err != nil && err != ctx.Err() {
+			return 0, fmt.Errorf("at %v = %v: %v", variable, x, err)
+		}
+		return y, err
+	}, nil
+}
+
+// checkVariable validates the name of the variable of a function, "x" when empty
+func checkVariable(name string) (string, error) {
+	if name == "" {
+		return "x", nil
+	}
+	tokens, err := tokenize(name)
+	if err != nil || len(tokens) != 2 || tokens[0].kind != tokIdent {
+		return "", fmt.Errorf("%q is not a name", name)
+	}
+	if err := checkSessionName(tokens[0]); err != nil {
+		return "", errors.New(err.(*exprError).msg)
+	}
+	return name, nil
+}
+
+func checkFiniteField(value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("%v is not finite", value)
+	}
+	return nil
+}
+
+// Nodes and weights of the 15-point Kronrod rule and of the 7-point Gauss
+// rule it extends, whose nodes are the odd ones of the Kronrod rule
+var (
+	kronrodNodes = [8]float64{
+		0.991455371120812639206854697526329, 0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926, 0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013, 0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245, 0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970, 0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518, 0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550, 0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649, 0.209482141084727828012999174891714,
+	}
+	gaussWeights = [4]float64{
+		0.129484966168869693270611432679082, 0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975, 0.417959183673469387755102040816327,
+	}
+)
+
+// quadInterval is a piece of the integration interval with its estimate
+type quadInterval struct {
+	a, b   float64
+	result float64
+	err    float64
+}
+
+// gaussKronrod integrates f over [a, b] with the 15-point Kronrod rule and
+// estimates the error by its difference with the 7-point Gauss rule
+func gaussKronrod(f realFunction, a, b float64) (quadInterval, error) {
+	center := (a + b) / 2
+	half := (b - a) / 2
+
+	fc, err := f(center)
+	if err != nil {
+		return quadInterval{}, err
+	}
+	kronrod := fc * kronrodWeights[7]
+	gauss := fc * gaussWeights[3]
+	for i := 0; i < 7; i++ {
+		dx := half * kronrodNodes[i]
+		f1, err := f(center - dx)
+		if err != nil {
+			return quadInterval{}, err
+		}
+		f2, err := f(center + dx)
+		if err != nil {
+			return quadInterval{}, err
+		}
+		kronrod += (f1 + f2) * kronrodWeights[i]
+		if i%2 == 1 {
+			gauss += (f1 + f2) * gaussWeights[i/2]
+		}
+	}
+
+	result := kronrod * half
+	return quadInterval{
+		a:      a,
+		b:      b,
+		result: result,
+		err:    math.Abs((kronrod - gauss) * half),
+	}, nil
+}
+
+// quadHeap is a max-heap of intervals by error estimate
+type quadHeap []quadInterval
+
+func (h quadHeap) Len() int            { return len(h) }
+func (h quadHeap) Less(i, j int) bool  { return h[i].err > h[j].err }
+func (h quadHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *quadHeap) Push(x interface{}) { *h = append(*h, x.(quadInterval)) }
+func (h *quadHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// integrate computes the integral of f over [a, b], bisecting the interval
+// with the largest error estimate until the total meets the tolerance
+func integrate(ctx context.Context, f realFunction, a, b, tolerance float64, maxIterations uint32) (*calculatorpb.IntegrateResponse, error) {
+	sign := 1.0
+	if a > b {
+		a, b = b, a
+		sign = -1
+	}
+	if a == b {
+		// still report an expression that cannot be evaluated
+		if _, err := f(a); err != nil {
+			return nil, err
+		}
+		return &calculatorpb.IntegrateResponse{Converged: true}, nil
+	}
+
+	first, err := gaussKronrod(f, a, b)
+	if err != nil {
+		return nil, err
+	}
+	h := &quadHeap{first}
+	result, errEstimate := first.result, first.err
+
+	res := &calculatorpb.IntegrateResponse{}
+	for {
+		if errEstimate <= math.Max(tolerance, tolerance*math.Abs(result)) {
+			res.Converged = true
+			break
+		}
+		if res.Iterations == maxIterations {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		worst := heap.Pop(h).(quadInterval)
+		mid := (worst.a + worst.b) / 2
+		if mid <= worst.a || mid >= worst.b {
+			// the interval cannot be split any further in float64
+			heap.Push(h, worst)
+			break
+		}
+		left, err := gaussKronrod(f, worst.a, mid)
+		if err != nil {
+			return nil, err
+		}
+		right, err := gaussKronrod(f, mid, worst.b)
+		if err != nil {
+			return nil, err
+		}
+		heap.Push(h, left)
+		heap.Push(h, right)
+		res.Iterations++
+
+		result += left.result + right.result - worst.result
+		errEstimate += left.err + right.err - worst.err
+	}
+
+	// sum again from the pieces rather than keep the running differences
+	result, errEstimate = 0, 0
+	for _, piece := range *h {
+		result += piece.result
+		errEstimate += piece.err
+	}
+	res.Result = sign * result
+	res.ErrorEstimate = errEstimate
+	return res, nil
+}
+
+// findRoot finds a root of f in [a, b], where f changes sign, with Brent's
+// method: inverse quadratic interpolation or the secant method when they
+// make progress, bisection otherwise
+func findRoot(ctx context.Context, f realFunction, a, b, tolerance float64, maxIterations uint32) (*calculatorpb.FindRootResponse, error) {
+	fa, err := f(a)
+	if err != nil {
+		return nil, err
+	}
+	fb, err := f(b)
+	if err != nil {
+		return nil, err
+	}
+	if fa == 0 {
+		return &calculatorpb.FindRootResponse{Root: a, Converged: true}, nil
+	}
+	if fb == 0 {
+		return &calculatorpb.FindRootResponse{Root: b, Converged: true}, nil
+	}
+	if (fa > 0) == (fb > 0) {
+		return nil, bracketError{a: a, b: b, fa: fa, fb: fb}
+	}
+
+	c, fc := b, fb
+	d := b - a
+	e := d
+	res := &calculatorpb.FindRootResponse{}
+	for {
+		if (fb > 0) == (fc > 0) {
+			// keep the root between b and c
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*0x1p-52*math.Abs(b) + tolerance/2
+		m := (c - b) / 2
+		res.Root, res.Value, res.ErrorEstimate = b, fb, math.Abs(m)
+		if math.Abs(m) <= tol || fb == 0 {
+			res.Converged = true
+			return res, nil
+		}
+		if res.Iterations == maxIterations {
+			return res, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		res.Iterations++
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// secant
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// inverse quadratic interpolation
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = m
+				e = d
+			}
+		} else {
+			d = m
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		if fb, err = f(b); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// bracketError is returned when the function has the same sign at both ends
+type bracketError struct {
+	a, b, fa, fb float64
+}
+
+func (e bracketError) Error() string {
+	return fmt.Sprintf("the function does not change sign between %v and %v, where it is %v and %v", e.a, e.b, e.fa, e.fb)
+}
+
+// calculusError maps the errors of integrate and findRoot to a status
+func calculusError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if _, ok := err.(bracketError); ok {
+		return bracketArgument(err)
+	}
+	return invalidArgument("expression", err)
+}
+
+// bracketArgument is invalidArgument about both ends of the bracket, which are
+// only wrong together
+func bracketArgument(err error) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid lower and upper: %v", err))
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "lower", Description: err.Error()},
+			{Field: "upper", Description: err.Error()},
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (*server) Integrate(ctx context.Context, in *calculatorpb.IntegrateRequest) (*calculatorpb.IntegrateResponse, error) {
+	fmt.Printf("Received Integrate RPC: %v\n", in)
+
+	variable, err := checkVariable(in.GetVariable())
+	if err != nil {
+		return nil, invalidArgument("variable", err)
+	}
+	f, err := compileFunction(ctx, in.GetExpression(), variable)
+	if err != nil {
+		return nil, invalidArgument("expression", err)
+	}
+	if err := checkFiniteField(in.GetLower()); err != nil {
+		return nil, invalidArgument("lower", err)
+	}
+	if err := checkFiniteField(in.GetUpper()); err != nil {
+		return nil, invalidArgument("upper", err)
+	}
+	tolerance := in.GetTolerance()
+	if tolerance < 0 || math.IsNaN(tolerance) || math.IsInf(tolerance, 0) {
+		return nil, invalidArgument("tolerance", fmt.Errorf("tolerance %v is not a positive number", tolerance))
+	}
+	if tolerance == 0 {
+		tolerance = defaultIntegrateTolerance
+	}
+	iterations := in.GetMaxIterations()
+	if iterations > maxIntegrateIterations {
+		return nil, invalidArgument("max_iterations", fmt.Errorf("at most %d iterations are supported", maxIntegrateIterations))
+	}
+	if iterations == 0 {
+		iterations = defaultIntegrateIterations
+	}
+
+	res, err := integrate(ctx, f, in.GetLower(), in.GetUpper(), tolerance, iterations)
+	if err != nil {
+		return nil, calculusError(ctx, err)
+	}
+	return res, nil
+}
+
+func (*server) FindRoot(ctx context.Context, in *calculatorpb.FindRootRequest) (*calculatorpb.FindRootResponse, error) {
+	fmt.Printf("Received FindRoot RPC: %v\n", in)
+
+	variable, err := checkVariable(in.GetVariable())
+	if err != nil {
+		return nil, invalidArgument("variable", err)
+	}
+	f, err := compileFunction(ctx, in.GetExpression(), variable)
+	if err != nil {
+		return nil, invalidArgument("expression", err)
+	}
+	if err := checkFiniteField(in.GetLower()); err != nil {
+		return nil, invalidArgument("lower", err)
+	}
+	if err := checkFiniteField(in.GetUpper()); err != nil {
+		return nil, invalidArgument("upper", err)
+	}
+	tolerance := in.GetTolerance()
+	if tolerance < 0 || math.IsNaN(tolerance) || math.IsInf(tolerance, 0) {
+		return nil, invalidArgument("tolerance", fmt.Errorf("tolerance %v is not a positive number", tolerance))
+	}
+	if tolerance == 0 {
+		tolerance = defaultRootTolerance
+	}
+	iterations := in.GetMaxIterations()
+	if iterations > maxRootIterations {
+		return nil, invalidArgument("max_iterations", fmt.Errorf("at most %d iterations are supported", maxRootIterations))
+	}
+	if iterations == 0 {
+		iterations = defaultRootIterations
+	}
+
+	res, err := findRoot(ctx, f, in.GetLower(), in.GetUpper(), tolerance, iterations)
+	if err != nil {
+		return nil, calculusError(ctx, err)
+	}
+	return res, nil
+}
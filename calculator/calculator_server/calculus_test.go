@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+func TestFindRoot(t *testing.T) {
+	in := &calculatorpb.FindRootRequest{Expression: "x^2 - 2", Lower: 0, Upper: 2}
+	res, err := (&server{}).FindRoot(context.Background(), in)
+	if err != nil {
+		t.Fatalf("FindRoot(%v) failed: %v", in, err)
+	}
+	if !res.GetConverged() || math.Abs(res.GetRoot()-math.Sqrt2) > 1e-12 {
+		t.Errorf("FindRoot(%v) = %v, want %v", in, res, math.Sqrt2)
+	}
+}
+
+func TestFindRootWithoutSignChange(t *testing.T) {
+	in := &calculatorpb.FindRootRequest{Expression: "x^2 + 1", Lower: -1, Upper: 1}
+	_, err := (&server{}).FindRoot(context.Background(), in)
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("FindRoot(%v): got %v, want code %v", in, err, codes.InvalidArgument)
+	}
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	if want := []string{"lower", "upper"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("FindRoot(%v): error is about %v, want %v", in, fields, want)
+	}
+}
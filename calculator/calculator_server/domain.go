@@ -0,0 +1,440 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"strconv"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// exactEvaluator evaluates expressions with exact rationals. In the integer
+// domain every number and intermediate result must be an integer.
+type exactEvaluator struct {
+	integer bool
+}
+
+func (e *exactEvaluator) domain() string {
+	if e.integer {
+		return "integer"
+	}
+	return "rational"
+}
+
+func (e *exactEvaluator) eval(n exprNode) (*big.Rat, error) {
+	switch n := n.(type) {
+	case *numberNode:
+		// decimals such as 0.1 are read exactly, as 1/10
+		x, err := parseBigNumber(&calculatorpb.BigNumber{Value: n.text})
+		if err != nil {
+			return nil, errorAt(n.col, "%v", err)
+		}
+		return x, e.check(n.col, x)
+	case *identNode:
+		if _, ok := constants[n.name]; ok {
+			return nil, errorAt(n.col, "%v is irrational, use the real domain", n.name)
+		}
+		if _, ok := builtins[n.name]; ok {
+			return nil, errorAt(n.col, "function %v must be called with arguments", n.name)
+		}
+		return nil, errorAt(n.col, "unknown variable %v", n.name)
+	case *unaryNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return nil, err
+		}
+		return x.Neg(x), nil
+	case *binaryNode:
+		return e.evalBinary(n)
+	case *callNode:
+		return e.evalCall(n)
+	}
+	return nil, errorAt(n.pos(), "cannot evaluate %T", n)
+}
+
+// check rejects results that leave the domain or grow too large
+func (e *exactEvaluator) check(col int, x *big.Rat) error {
+	if e.integer && !x.IsInt() {
+		return errorAt(col, "%v is not an integer, use the rational domain", x.RatString())
+	}
+	if x.Num().BitLen() > maxBigResultBits || x.Denom().BitLen() > maxBigResultBits {
+		return errorAt(col, "result has more than %d bits", maxBigResultBits)
+	}
+	return nil
+}
+
+func (e *exactEvaluator) evalBinary(n *binaryNode) (*big.Rat, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return nil, err
+	}
+	y, err := e.eval(n.y)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(big.Rat)
+	switch n.op {
+	case '+':
+		res.Add(x, y)
+	case '-':
+		res.Sub(x, y)
+	case '*':
+		res.Mul(x, y)
+	case '/':
+		if y.Sign() == 0 {
+			return nil, errorAt(n.col, "division by zero")
+		}
+		res.Quo(x, y)
+	case '%':
+		if !x.IsInt() || !y.IsInt() {
+			return nil, errorAt(n.col, "%% needs integers, got %v and %v", x.RatString(), y.RatString())
+		}
+		if y.Sign() == 0 {
+			return nil, errorAt(n.col, "modulo by zero")
+		}
+		// the remainder has the sign of x, as in the real domain
+		res.SetInt(new(big.Int).Rem(x.Num(), y.Num()))
+	case '^':
+		if res, err = bigPower(x, y); err != nil {
+			return nil, errorAt(n.col, "%v", err)
+		}
+	default:
+		return nil, errorAt(n.col, "unknown operator %q", n.op)
+	}
+	return res, e.check(n.col, res)
+}
+
+func (e *exactEvaluator) evalCall(n *callNode) (*big.Rat, error) {
+	f, ok := builtins[n.name]
+	if !ok {
+		return nil, errorAt(n.col, "unknown function %v", n.name)
+	}
+	if len(n.args) < f.minArgs || (f.maxArgs >= 0 && len(n.args) > f.maxArgs) {
+		return nil, errorAt(n.col, "%v expects %v, got %d", n.name, arity(f.minArgs, f.maxArgs), len(n.args))
+	}
+
+	args := make([]*big.Rat, len(n.args))
+	for i, arg := range n.args {
+		v, err := e.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	x := args[0]
+	res := new(big.Rat)
+	switch n.name {
+	case "abs":
+		res.Abs(x)
+	case "min", "max":
+		res.Set(x)
+		for _, v := range args[1:] {
+			if c := v.Cmp(res); (c < 0 && n.name == "min") || (c > 0 && n.name == "max") {
+				res.Set(v)
+			}
+		}
+	case "floor":
+		res.SetInt(ratFloor(x))
+	case "ceil":
+		res.SetInt(ratFloor(new(big.Rat).Neg(x)))
+		res.Neg(res)
+	case "round":
+		// half away from zero, as in the real domain
+		half := new(big.Rat).Abs(x)
+		half.Add(half, big.NewRat(1, 2))
+		res.SetInt(ratFloor(half))
+		if x.Sign() < 0 {
+			res.Neg(res)
+		}
+	case "sqrt":
+		if x.Sign() < 0 {
+			return nil, errorAt(n.col, "sqrt of the negative number %v, use the complex domain", x.RatString())
+		}
+		root, exact := bigSquareRoot(x, 0)
+		if !exact {
+			return nil, errorAt(n.col, "sqrt(%v) is irrational, use the real domain", x.RatString())
+		}
+		res.SetString(root)
+	default:
+		return nil, errorAt(n.col, "%v has no exact value in the %v domain, use the real domain", n.name, e.domain())
+	}
+	return res, e.check(n.col, res)
+}
+
+// ratFloor returns the largest integer not above x
+func ratFloor(x *big.Rat) *big.Int {
+	// Div rounds towards negative infinity for the positive denominator
+	return new(big.Int).Div(x.Num(), x.Denom())
+}
+
+// complexBuiltins are the functions with a complex version. Other builtins
+// are available in the complex domain for real arguments.
+var complexBuiltins = map[string]struct {
+	minArgs, maxArgs int
+	fn               func(args []complex128) complex128
+}{
+	"sqrt":  {1, 1, func(a []complex128) complex128 { return cmplx.Sqrt(a[0]) }},
+	"exp":   {1, 1, func(a []complex128) complex128 { return cmplx.Exp(a[0]) }},
+	"ln":    {1, 1, func(a []complex128) complex128 { return cmplx.Log(a[0]) }},
+	"log":   {1, 2, complexLogN},
+	"log2":  {1, 1, func(a []complex128) complex128 { return cmplx.Log(a[0]) / math.Ln2 }},
+	"log10": {1, 1, func(a []complex128) complex128 { return cmplx.Log10(a[0]) }},
+	"sin":   {1, 1, func(a []complex128) complex128 { return cmplx.Sin(a[0]) }},
+	"cos":   {1, 1, func(a []complex128) complex128 { return cmplx.Cos(a[0]) }},
+	"tan":   {1, 1, func(a []complex128) complex128 { return cmplx.Tan(a[0]) }},
+	"asin":  {1, 1, func(a []complex128) complex128 { return cmplx.Asin(a[0]) }},
+	"acos":  {1, 1, func(a []complex128) complex128 { return cmplx.Acos(a[0]) }},
+	"atan":  {1, 1, func(a []complex128) complex128 { return cmplx.Atan(a[0]) }},
+	"sinh":  {1, 1, func(a []complex128) complex128 { return cmplx.Sinh(a[0]) }},
+	"cosh":  {1, 1, func(a []complex128) complex128 { return cmplx.Cosh(a[0]) }},
+	"tanh":  {1, 1, func(a []complex128) complex128 { return cmplx.Tanh(a[0]) }},
+	"abs":   {1, 1, func(a []complex128) complex128 { return complex(cmplx.Abs(a[0]), 0) }},
+	"re":    {1, 1, func(a []complex128) complex128 { return complex(real(a[0]), 0) }},
+	"im":    {1, 1, func(a []complex128) complex128 { return complex(imag(a[0]), 0) }},
+	"arg":   {1, 1, func(a []complex128) complex128 { return complex(cmplx.Phase(a[0]), 0) }},
+	"conj":  {1, 1, func(a []complex128) complex128 { return cmplx.Conj(a[0]) }},
+}
+
+func complexLogN(a []complex128) complex128 {
+	if len(a) == 2 {
+		return cmplx.Log(a[0]) / cmplx.Log(a[1])
+	}
+	return cmplx.Log(a[0])
+}
+
+// complexEvaluator evaluates expressions with complex128 arithmetic,
+// where the identifier i is the imaginary unit
+type complexEvaluator struct{}
+
+func (e *complexEvaluator) eval(n exprNode) (complex128, error) {
+	switch n := n.(type) {
+	case *numberNode:
+		return complex(n.value, 0), nil
+	case *identNode:
+		if n.name == "i" {
+			return 1i, nil
+		}
+		if v, ok := constants[n.name]; ok {
+			return complex(v, 0), nil
+		}
+		_, isComplex := complexBuiltins[n.name]
+		if _, ok := builtins[n.name]; ok || isComplex {
+			return 0, errorAt(n.col, "function %v must be called with arguments", n.name)
+		}
+		return 0, errorAt(n.col, "unknown variable %v", n.name)
+	case *unaryNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return 0, err
+		}
+		// 0 - x rather than -x, so that -4 is not -4-0i, whose root is -2i
+		return 0 - x, nil
+	case *binaryNode:
+		return e.evalBinary(n)
+	case *callNode:
+		return e.evalCall(n)
+	}
+	return 0, errorAt(n.pos(), "cannot evaluate %T", n)
+}
+
+func (e *complexEvaluator) evalBinary(n *binaryNode) (complex128, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return 0, err
+	}
+	y, err := e.eval(n.y)
+	if err != nil {
+		return 0, err
+	}
+
+	var res complex128
+	switch n.op {
+	case '+':
+		res = x + y
+	case '-':
+		res = x - y
+	case '*':
+		res = x * y
+	case '/':
+		if y == 0 {
+			return 0, errorAt(n.col, "division by zero")
+		}
+		res = x / y
+	case '%':
+		if imag(x) != 0 || imag(y) != 0 {
+			return 0, errorAt(n.col, "%% is only defined for real numbers")
+		}
+		if y == 0 {
+			return 0, errorAt(n.col, "modulo by zero")
+		}
+		res = complex(math.Mod(real(x), real(y)), 0)
+	case '^':
+		res = complexPower(x, y)
+	default:
+		return 0, errorAt(n.col, "unknown operator %q", n.op)
+	}
+	return checkComplexResult(n.col, string(n.op), res)
+}
+
+func (e *complexEvaluator) evalCall(n *callNode) (complex128, error) {
+	args := make([]complex128, len(n.args))
+	for i, arg := range n.args {
+		v, err := e.eval(arg)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+
+	if f, ok := complexBuiltins[n.name]; ok {
+		if len(n.args) < f.minArgs || len(n.args) > f.maxArgs {
+			return 0, errorAt(n.col, "%v expects %v, got %d", n.name, arity(f.minArgs, f.maxArgs), len(n.args))
+		}
+		return checkComplexResult(n.col, n.name, f.fn(args))
+	}
+
+	f, ok := builtins[n.name]
+	if !ok {
+		return 0, errorAt(n.col, "unknown function %v", n.name)
+	}
+	if len(n.args) < f.minArgs || (f.maxArgs >= 0 && len(n.args) > f.maxArgs) {
+		return 0, errorAt(n.col, "%v expects %v, got %d", n.name, arity(f.minArgs, f.maxArgs), len(n.args))
+	}
+	realArgs := make([]float64, len(args))
+	for i, arg := range args {
+		if imag(arg) != 0 {
+			return 0, errorAt(n.args[i].pos(), "%v is only defined for real numbers, got %v", n.name, formatComplex(arg))
+		}
+		realArgs[i] = real(arg)
+	}
+	res, err := checkResult(n.col, n.name, f.fn(realArgs))
+	return complex(res, 0), err
+}
+
+// complexPower raises x to y, multiplying out small integer powers so that
+// i^2 is exactly -1 rather than cmplx.Pow's -1+1.2e-16i
+func complexPower(x, y complex128) complex128 {
+	n := real(y)
+	if imag(y) != 0 || n != math.Trunc(n) || math.Abs(n) > 1<<20 || x == 0 {
+		return cmplx.Pow(x, y)
+	}
+
+	res := complex(1, 0)
+	for k, base := int64(math.Abs(n)), x; k > 0; k >>= 1 {
+		if k&1 == 1 {
+			res *= base
+		}
+		base *= base
+	}
+	if n < 0 {
+		return 1 / res
+	}
+	return res
+}
+
+// checkComplexResult rejects NaN and infinite results of op
+func checkComplexResult(col int, op string, res complex128) (complex128, error) {
+	switch {
+	case cmplx.IsNaN(res):
+		return 0, errorAt(col, "%v is undefined for these arguments", op)
+	case cmplx.IsInf(res):
+		return 0, errorAt(col, "%v overflows", op)
+	}
+	return res, nil
+}
+
+func formatReal(x float64) string {
+	if x == 0 {
+		x = 0 // no negative zero
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// formatComplex writes z as 3, 2i, -i or 3-4i
+func formatComplex(z complex128) string {
+	re, im := real(z), imag(z)
+	if im == 0 {
+		return formatReal(re)
+	}
+
+	coefficient := formatReal(math.Abs(im))
+	if coefficient == "1" {
+		coefficient = ""
+	}
+	sign := "+"
+	if im < 0 {
+		sign = "-"
+	}
+	if re == 0 {
+		if sign == "+" {
+			sign = ""
+		}
+		return sign + coefficient + "i"
+	}
+	return formatReal(re) + sign + coefficient + "i"
+}
+
+// complexNumber converts z to its message, dropping negative zeros
+func complexNumber(z complex128) *calculatorpb.ComplexNumber {
+	return &calculatorpb.ComplexNumber{Real: real(z) + 0, Imaginary: imag(z) + 0}
+}
+
+func validNumberDomain(domain calculatorpb.NumberDomain) error {
+	if _, ok := calculatorpb.NumberDomain_name[int32(domain)]; !ok {
+		return fmt.Errorf("unsupported number domain %v", domain)
+	}
+	return nil
+}
+
+// evaluateInDomain parses and evaluates src in the given number domain
+func evaluateInDomain(src string, domain calculatorpb.NumberDomain) (*calculatorpb.EvaluateResponse, error) {
+	switch domain {
+	case calculatorpb.NumberDomain_NUMBER_DOMAIN_INTEGER, calculatorpb.NumberDomain_NUMBER_DOMAIN_RATIONAL:
+		node, err := parseExpr(src)
+		if err != nil {
+			return nil, err
+		}
+		e := &exactEvaluator{integer: domain == calculatorpb.NumberDomain_NUMBER_DOMAIN_INTEGER}
+		x, err := e.eval(node)
+		if err != nil {
+			return nil, err
+		}
+		approx, _ := x.Float64()
+		return &calculatorpb.EvaluateResponse{
+			Result:      approx,
+			ExactResult: formatBigNumber(x),
+			Text:        x.RatString(),
+		}, nil
+
+	case calculatorpb.NumberDomain_NUMBER_DOMAIN_COMPLEX:
+		node, err := parseComplexExpr(src)
+		if err != nil {
+			return nil, err
+		}
+		z, err := (&complexEvaluator{}).eval(node)
+		if err != nil {
+			return nil, err
+		}
+		res := &calculatorpb.EvaluateResponse{
+			ComplexResult: complexNumber(z),
+			Text:          formatComplex(z),
+		}
+		if imag(z) == 0 {
+			res.Result = real(z)
+		}
+		return res, nil
+	}
+
+	x, err := evaluate(src)
+	if err != nil {
+		return nil, err
+	}
+	return &calculatorpb.EvaluateResponse{
+		Result: x,
+		Text:   formatReal(x),
+	}, nil
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// builtin is a function that can be called from an expression.
+// maxArgs is -1 for functions taking any number of arguments.
+type builtin struct {
+	minArgs int
+	maxArgs int
+	fn      func(args []float64) float64
+}
+
+var builtins = map[string]builtin{
+	"sqrt":  {1, 1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"cbrt":  {1, 1, func(a []float64) float64 { return math.Cbrt(a[0]) }},
+	"abs":   {1, 1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"exp":   {1, 1, func(a []float64) float64 { return math.Exp(a[0]) }},
+	"ln":    {1, 1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"log":   {1, 2, logN},
+	"log2":  {1, 1, func(a []float64) float64 { return math.Log2(a[0]) }},
+	"log10": {1, 1, func(a []float64) float64 { return math.Log10(a[0]) }},
+	"sin":   {1, 1, func(a []float64) float64 { return math.Sin(a[0]) }},
+	"cos":   {1, 1, func(a []float64) float64 { return math.Cos(a[0]) }},
+	"tan":   {1, 1, func(a []float64) float64 { return math.Tan(a[0]) }},
+	"asin":  {1, 1, func(a []float64) float64 { return math.Asin(a[0]) }},
+	"acos":  {1, 1, func(a []float64) float64 { return math.Acos(a[0]) }},
+	"atan":  {1, 1, func(a []float64) float64 { return math.Atan(a[0]) }},
+	"sinh":  {1, 1, func(a []float64) float64 { return math.Sinh(a[0]) }},
+	"cosh":  {1, 1, func(a []float64) float64 { return math.Cosh(a[0]) }},
+	"tanh":  {1, 1, func(a []float64) float64 { return math.Tanh(a[0]) }},
+	"floor": {1, 1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"ceil":  {1, 1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"round": {1, 1, func(a []float64) float64 { return math.Round(a[0]) }},
+	"min":   {1, -1, minOf},
+	"max":   {1, -1, maxOf},
+}
+
+var constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// logN is log(x), the natural logarithm, or log(x, base)
+func logN(a []float64) float64 {
+	if len(a) == 2 {
+		return math.Log(a[0]) / math.Log(a[1])
+	}
+	return math.Log(a[0])
+}
+
+func minOf(a []float64) float64 {
+	res := a[0]
+	for _, v := range a[1:] {
+		res = math.Min(res, v)
+	}
+	return res
+}
+
+func maxOf(a []float64) float64 {
+	res := a[0]
+	for _, v := range a[1:] {
+		res = math.Max(res, v)
+	}
+	return res
+}
+
+// userFunc is a function defined in a session, such as def f(a, b) = a*b
+type userFunc struct {
+	params []string
+	body   exprNode
+}
+
+// Limits of an evaluator with a context
+const (
+	maxCallDepth   = 100  // nested calls of user functions
+	evalCheckEvery = 1024 // nodes evaluated between checks of the context
+)
+
+// evaluator evaluates expression trees with float64 arithmetic.
+// Results that are not finite numbers are reported as errors at the
+// column of the operation that produced them.
+type evaluator struct {
+	vars  map[string]float64  // looked up before the constants
+	funcs map[string]userFunc // looked up before the builtins
+
+	// ctx bounds the evaluation time when set, as user functions can
+	// call each other an exponential number of times
+	ctx    context.Context
+	locals map[string]float64 // arguments of the user function being called
+	depth  int
+	steps  int
+}
+
+func evaluate(src string) (float64, error) {
+	node, err := parseExpr(src)
+	if err != nil {
+		return 0, err
+	}
+	return (&evaluator{}).eval(node)
+}
+
+func (e *evaluator) eval(n exprNode) (float64, error) {
+	if e.ctx != nil {
+		e.steps++
+		if e.steps%evalCheckEvery == 0 && e.ctx.Err() != nil {
+			return 0, e.ctx.Err()
+		}
+	}
+
+	switch n := n.(type) {
+	case *numberNode:
+		return n.value, nil
+	case *identNode:
+		if v, ok := e.locals[n.name]; ok {
+			return v, nil
+		}
+		if v, ok := e.vars[n.name]; ok {
+			return v, nil
+		}
+		if v, ok := constants[n.name]; ok {
+			return v, nil
+		}
+		_, isUserFunc := e.funcs[n.name]
+		if _, ok := builtins[n.name]; ok || isUserFunc {
+			return 0, errorAt(n.col, "function %v must be called with arguments", n.name)
+		}
+		return 0, errorAt(n.col, "unknown variable %v", n.name)
+	case *unaryNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return 0, err
+		}
+		return -x, nil
+	case *binaryNode:
+		return e.evalBinary(n)
+	case *callNode:
+		return e.evalCall(n)
+	}
+	return 0, errorAt(n.pos(), "cannot evaluate %T", n)
+}
+
+func (e *evaluator) evalBinary(n *binaryNode) (float64, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return 0, err
+	}
+	y, err := e.eval(n.y)
+	if err != nil {
+		return 0, err
+	}
+
+	var res float64
+	switch n.op {
+	case '+':
+		res = x + y
+	case '-':
+		res = x - y
+	case '*':
+		res = x * y
+	case '/':
+		if y == 0 {
+			return 0, errorAt(n.col, "division by zero")
+		}
+		res = x / y
+	case '%':
+		if y == 0 {
+			return 0, errorAt(n.col, "modulo by zero")
+		}
+		res = math.Mod(x, y)
+	case '^':
+		res = math.Pow(x, y)
+	default:
+		return 0, errorAt(n.col, "unknown operator %q", n.op)
+	}
+	return checkResult(n.col, string(n.op), res)
+}
+
+func (e *evaluator) evalCall(n *callNode) (float64, error) {
+	if f, ok := e.funcs[n.name]; ok {
+		return e.callUserFunc(n, f)
+	}
+	f, ok := builtins[n.name]
+	if !ok {
+		return 0, errorAt(n.col, "unknown function %v", n.name)
+	}
+	if len(n.args) < f.minArgs || (f.maxArgs >= 0 && len(n.args) > f.maxArgs) {
+		return 0, errorAt(n.col, "%v expects %v, got %d", n.name, arity(f.minArgs, f.maxArgs), len(n.args))
+	}
+
+	args, err := e.evalArgs(n.args)
+	if err != nil {
+		return 0, err
+	}
+	return checkResult(n.col, n.name, f.fn(args))
+}
+
+func (e *evaluator) evalArgs(nodes []exprNode) ([]float64, error) {
+	args := make([]float64, len(nodes))
+	for i, arg := range nodes {
+		v, err := e.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return args, nil
+}
+
+// callUserFunc evaluates the body of f with its parameters bound to the
+// arguments. Errors in the body are reported at the column of the outermost
+// call, as the columns of the body refer to its definition.
+func (e *evaluator) callUserFunc(n *callNode, f userFunc) (float64, error) {
+	if len(n.args) != len(f.params) {
+		return 0, errorAt(n.col, "%v expects %v, got %d", n.name, arity(len(f.params), len(f.params)), len(n.args))
+	}
+	if e.depth == maxCallDepth {
+		return 0, errorAt(n.col, "calls of %v are nested more than %d deep", n.name, maxCallDepth)
+	}
+
+	args, err := e.evalArgs(n.args)
+	if err != nil {
+		return 0, err
+	}
+	locals := make(map[string]float64, len(args))
+	for i, param := range f.params {
+		locals[param] = args[i]
+	}
+
+	saved := e.locals
+	e.locals = locals
+	e.depth++
+	res, err := e.eval(f.body)
+	e.depth--
+	e.locals = saved
+
+	if exprErr, ok := err.(*exprError); ok && e.depth == 0 {
+		return 0, errorAt(n.col, "in %v: %v", n.name, exprErr.msg)
+	}
+	return res, err
+}
+
+func arity(min, max int) string {
+	switch {
+	case max < 0:
+		return fmt.Sprintf("%d or more arguments", min)
+	case min == max && min == 1:
+		return "1 argument"
+	case min == max:
+		return fmt.Sprintf("%d arguments", min)
+	default:
+		return fmt.Sprintf("%d to %d arguments", min, max)
+	}
+}
+
+// checkResult rejects NaN and infinite results of op
+func checkResult(col int, op string, res float64) (float64, error) {
+	switch {
+	case math.IsNaN(res):
+		return 0, errorAt(col, "%v is undefined for these arguments", op)
+	case math.IsInf(res, 0):
+		return 0, errorAt(col, "%v overflows", op)
+	}
+	return res, nil
+}
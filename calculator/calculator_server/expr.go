@@ -0,0 +1,381 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Arithmetic expressions are parsed into a small syntax tree.
+//
+// Grammar, from lowest to highest precedence:
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/" | "%") unary }
+//	unary   = "-" unary | "+" unary | power
+//	power   = primary [ "^" unary ]          right associative, so -2^2 = -4
+//	primary = number | ident | ident "(" [ expr { "," expr } ] ")" | "(" expr ")"
+//
+// Quantity expressions also accept a unit after a number, as in 3 km + 250 m:
+//
+//	primary = number [ ident ] | ...
+//
+// and complex expressions accept i after a number, as in 3 - 4i.
+//
+// Positions are 1-based columns into the source, counted in characters.
+
+type exprNode interface {
+	pos() int
+}
+
+type numberNode struct {
+	col   int
+	text  string
+	value float64
+}
+
+type identNode struct {
+	col  int
+	name string
+}
+
+type unaryNode struct {
+	col int
+	op  byte
+	x   exprNode
+}
+
+type binaryNode struct {
+	col  int // column of the operator
+	op   byte
+	x, y exprNode
+}
+
+// quantityNode is a number annotated with a unit, only in quantity expressions
+type quantityNode struct {
+	col     int
+	value   float64
+	unit    string
+	unitCol int
+}
+
+type callNode struct {
+	col  int
+	name string
+	args []exprNode
+}
+
+func (n *numberNode) pos() int { return n.col }
+func (n *identNode) pos() int  { return n.col }
+func (n *unaryNode) pos() int  { return n.col }
+func (n *binaryNode) pos() int { return n.col }
+func (n *callNode) pos() int   { return n.col }
+
+func (n *quantityNode) pos() int { return n.col }
+
+// exprError is a parse or evaluation error at a column of the expression
+type exprError struct {
+	col int
+	msg string
+}
+
+func (e *exprError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.col, e.msg)
+}
+
+func errorAt(col int, format string, args ...interface{}) *exprError {
+	return &exprError{col: col, msg: fmt.Sprintf(format, args...)}
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp // one of + - * / % ^ ( ) , =
+)
+
+type token struct {
+	kind tokenKind
+	col  int
+	text string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of expression"
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// tokenize splits src into tokens, ending with a tokEOF token
+func tokenize(src string) ([]token, error) {
+	runes := []rune(src)
+	tokens := []token{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		col := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			// exponent, e.g. 1e-3, only when digits follow
+			if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+				j := i + 1
+				if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+					j++
+				}
+				if j < len(runes) && unicode.IsDigit(runes[j]) {
+					for j < len(runes) && unicode.IsDigit(runes[j]) {
+						j++
+					}
+					i = j
+				}
+			}
+			tokens = append(tokens, token{kind: tokNumber, col: col, text: string(runes[start:i])})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, col: col, text: string(runes[start:i])})
+		case strings.ContainsRune("+-*/%^(),=", r):
+			tokens = append(tokens, token{kind: tokOp, col: col, text: string(r)})
+			i++
+		default:
+			return nil, errorAt(col, "unexpected character %q", r)
+		}
+	}
+	return append(tokens, token{kind: tokEOF, col: len(runes) + 1}), nil
+}
+
+// Limits of an expression, so that parsing and evaluating it cannot exhaust
+// the stack of the server
+const (
+	maxExprLength = 1 << 16 // characters
+	maxExprDepth  = 256     // nested parentheses, calls, signs and exponents
+)
+
+type parser struct {
+	tokens []token
+	next   int
+	depth  int  // of the unary being parsed
+	units  bool // numbers may be followed by a unit
+
+	imaginary bool // numbers may be followed by i
+}
+
+// parseExpr parses a complete expression
+func parseExpr(src string) (exprNode, error) {
+	return parseExprWith(src, func(*parser) {})
+}
+
+// parseQuantityExpr parses a complete expression whose numbers may carry units
+func parseQuantityExpr(src string) (exprNode, error) {
+	return parseExprWith(src, func(p *parser) { p.units = true })
+}
+
+// parseComplexExpr parses a complete expression where 2i is 2 * i
+func parseComplexExpr(src string) (exprNode, error) {
+	return parseExprWith(src, func(p *parser) { p.imaginary = true })
+}
+
+func parseExprWith(src string, setup func(*parser)) (exprNode, error) {
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+	setup(p)
+	node, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func newParser(src string) (*parser, error) {
+	if n := utf8.RuneCountInString(src); n > maxExprLength {
+		return nil, errorAt(maxExprLength+1, "expression has %d characters, at most %d are supported", n, maxExprLength)
+	}
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	if tokens[0].kind == tokEOF {
+		return nil, errorAt(1, "empty expression")
+	}
+	return &parser{tokens: tokens}, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.next]
+	if t.kind != tokEOF {
+		p.next++
+	}
+	return t
+}
+
+func (p *parser) isOp(ops string) bool {
+	t := p.peek()
+	return t.kind == tokOp && strings.Contains(ops, t.text)
+}
+
+func (p *parser) expectOp(op string) error {
+	t := p.advance()
+	if t.kind != tokOp || t.text != op {
+		return errorAt(t.col, "expected %q, found %v", op, t)
+	}
+	return nil
+}
+
+func (p *parser) expectEOF() error {
+	if t := p.peek(); t.kind != tokEOF {
+		return errorAt(t.col, "unexpected %v", t)
+	}
+	return nil
+}
+
+func (p *parser) expr() (exprNode, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+-") {
+		op := p.advance()
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{col: op.col, op: op.text[0], x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) term() (exprNode, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*/%") {
+		op := p.advance()
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{col: op.col, op: op.text[0], x: x, y: y}
+	}
+	return x, nil
+}
+
+// unary is entered once for every level of nesting, which it counts
+func (p *parser) unary() (exprNode, error) {
+	if p.depth == maxExprDepth {
+		return nil, errorAt(p.peek().col, "expression is nested more than %d levels deep", maxExprDepth)
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+
+	if p.isOp("+-") {
+		op := p.advance()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if op.text == "+" {
+			return x, nil
+		}
+		return &unaryNode{col: op.col, op: '-', x: x}, nil
+	}
+	return p.power()
+}
+
+func (p *parser) power() (exprNode, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("^") {
+		op := p.advance()
+		// the exponent may carry its own sign: 2^-1
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{col: op.col, op: '^', x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) primary() (exprNode, error) {
+	t := p.advance()
+	switch t.kind {
+	case tokNumber:
+		value, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, errorAt(t.col, "invalid number %q", t.text)
+		}
+		number := &numberNode{col: t.col, text: t.text, value: value}
+		next := p.peek()
+		if p.units && next.kind == tokIdent {
+			p.advance()
+			return &quantityNode{col: t.col, value: value, unit: next.text, unitCol: next.col}, nil
+		}
+		if p.imaginary && next.kind == tokIdent && next.text == "i" {
+			p.advance()
+			return &binaryNode{col: next.col, op: '*', x: number, y: &identNode{col: next.col, name: "i"}}, nil
+		}
+		return number, nil
+	case tokIdent:
+		if !p.isOp("(") {
+			return &identNode{col: t.col, name: t.text}, nil
+		}
+		p.advance()
+		args := []exprNode{}
+		if !p.isOp(")") {
+			for {
+				arg, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+				if !p.isOp(",") {
+					break
+				}
+				p.advance()
+			}
+		}
+		if err := p.expectOp(")"); err != nil {
+			return nil, err
+		}
+		return &callNode{col: t.col, name: t.text, args: args}, nil
+	case tokOp:
+		if t.text == "(" {
+			x, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectOp(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, errorAt(t.col, "unexpected %v", t)
+}
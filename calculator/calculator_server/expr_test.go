@@ -0,0 +1,64 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		src  string
+		want float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"2 ^ 3 ^ 2", 512},
+		{"-2^2", -4},
+		{"10 % 4", 2},
+		{"7 / 2", 3.5},
+		{"sqrt(16) + abs(-3)", 7},
+		{"log(8, 2)", 3},
+		{"min(3, 2) * max(4, 5)", 10},
+		{"2 * pi", 2 * math.Pi},
+		{strings.Repeat("(", 200) + "1" + strings.Repeat(")", 200), 1},
+	}
+	for _, tt := range tests {
+		got, err := evaluate(tt.src)
+		if err != nil {
+			t.Errorf("evaluate(%.40q) failed: %v", tt.src, err)
+			continue
+		}
+		if math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("evaluate(%.40q) = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string // a part of the error message
+	}{
+		{"", "column 1"},
+		{"1 +", "column 4"},
+		{"(1 + 2", "column 7"},
+		{"2 $ 3", "column 3"},
+		{"foo(1)", "foo"},
+		{strings.Repeat("(", 300) + "1" + strings.Repeat(")", 300), "nested more than 256 levels"},
+		{strings.Repeat("-", 300) + "1", "nested more than 256 levels"},
+		{strings.Repeat("2^", 300) + "2", "nested more than 256 levels"},
+		{strings.Repeat("(", 2_000_000), "at most 65536 are supported"},
+		{strings.Repeat("(", 2_000_000), "column 65537"},
+	}
+	for _, tt := range tests {
+		_, err := evaluate(tt.src)
+		if err == nil {
+			t.Errorf("evaluate(%.40q) succeeded, want an error containing %q", tt.src, tt.want)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("evaluate(%.40q) failed with %q, want %q in it", tt.src, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"math/big"
+)
+
+const (
+	// millerRabinRounds is passed to big.Int.ProbablyPrime, which runs that many
+	// Miller-Rabin rounds with random bases plus a Baillie-PSW test. It is exact
+	// below 2^64 and has no known counterexample above.
+	millerRabinRounds = 20
+
+	// trialDivisionLimit is the largest divisor tried before switching to Pollard's rho
+	trialDivisionLimit = 1000
+
+	// maxFactorizeBits bounds the inputs accepted for factorization
+	maxFactorizeBits = 4096
+)
+
+var (
+	bigOne = big.NewInt(1)
+	bigTwo = big.NewInt(2)
+)
+
+// factorize finds the prime factors of n > 1 and calls emit once per distinct
+// prime with its multiplicity, as soon as the prime is found. Small primes
+// come first, in increasing order; larger ones in the order Pollard's rho finds
+// them. It returns ctx.Err() when ctx is done before n is fully factored.
+func factorize(ctx context.Context, n *big.Int, emit func(prime *big.Int, multiplicity uint32) error) error {
+	rest := new(big.Int).Set(n)
+
+	for d := int64(2); d <= trialDivisionLimit; d++ {
+		p := big.NewInt(d)
+		if new(big.Int).Mul(p, p).Cmp(rest) > 0 {
+			break
+		}
+		if k := divideOut(rest, p); k > 0 {
+			if err := emit(p, k); err != nil {
+				return err
+			}
+		}
+	}
+
+	for rest.Cmp(bigOne) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if rest.ProbablyPrime(millerRabinRounds) {
+			return emit(new(big.Int).Set(rest), 1)
+		}
+
+		// split until we hold a prime factor of rest
+		p := rest
+		for !p.ProbablyPrime(millerRabinRounds) {
+			d, err := pollardRho(ctx, p)
+			if err != nil {
+				return err
+			}
+			p = d
+		}
+		p = new(big.Int).Set(p)
+		if err := emit(p, divideOut(rest, p)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// divideOut divides n by p as often as possible and returns how often that was
+func divideOut(n, p *big.Int) uint32 {
+	k := uint32(0)
+	q, r := new(big.Int), new(big.Int)
+	for {
+		q.QuoRem(n, p, r)
+		if r.Sign() != 0 {
+			return k
+		}
+		n.Set(q)
+		k++
+	}
+}
+
+// pollardRho returns a non-trivial divisor of the odd composite n,
+// using Brent's cycle detection with the polynomial x^2 + c.
+func pollardRho(ctx context.Context, n *big.Int) (*big.Int, error) {
+	if n.Bit(0) == 0 {
+		return big.NewInt(2), nil
+	}
+
+	const batch = 128 // gcd is taken once per batch of products
+
+	x, y, ys := new(big.Int), new(big.Int), new(big.Int)
+	q, g, diff := new(big.Int), new(big.Int), new(big.Int)
+	step := func(v, c *big.Int) {
+		v.Mul(v, v)
+		v.Add(v, c)
+		v.Mod(v, n)
+	}
+
+	for c := int64(1); ; c++ {
+		cc := big.NewInt(c)
+		y.SetInt64(2)
+		q.SetInt64(1)
+		g.SetInt64(1)
+
+		for r := 1; g.Cmp(bigOne) == 0; r *= 2 {
+			x.Set(y)
+			for i := 0; i < r; i++ {
+				if i%batch == 0 && ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				step(y, cc)
+			}
+			for k := 0; k < r && g.Cmp(bigOne) == 0; k += batch {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				ys.Set(y)
+				for i := 0; i < batch && i < r-k; i++ {
+					step(y, cc)
+					diff.Sub(x, y)
+					q.Mul(q, diff.Abs(diff))
+					q.Mod(q, n)
+				}
+				g.GCD(nil, nil, q, n)
+			}
+		}
+
+		if g.Cmp(n) == 0 {
+			// the batch overshot, redo it one step at a time
+			for {
+				step(ys, cc)
+				diff.Sub(x, ys)
+				g.GCD(nil, nil, diff.Abs(diff), n)
+				if g.Cmp(bigOne) != 0 {
+					break
+				}
+			}
+		}
+		if g.Cmp(n) != 0 {
+			return new(big.Int).Set(g), nil
+		}
+		// this polynomial cycled without a factor, try the next one
+	}
+}
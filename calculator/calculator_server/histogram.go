@@ -0,0 +1,430 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// Limits and defaults of a histogram configuration
+const (
+	maxHistogramBins          = 10000
+	defaultHistogramBins      = 10
+	defaultDigestCompression  = 100
+	minDigestCompression      = 10
+	maxDigestCompression      = 10000
+	digestBufferCompressions  = 5 // numbers buffered before a merge, in multiples of the compression
+	freedmanDiaconisIQRFactor = 2
+)
+
+var defaultHistogramPercentiles = []float64{50, 90, 95, 99}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// tDigest is a merging t-digest. Numbers are buffered and merged into
+// centroids sorted by mean, whose size is bounded by the k2 scale function so
+// clusters stay small near the tails, where quantile estimates need it most.
+type tDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []centroid
+	count       float64
+	min, max    float64
+}
+
+func newTDigest(compression float64) *tDigest {
+	return &tDigest{
+		compression: compression,
+		buffer:      make([]centroid, 0, int(compression)*digestBufferCompressions),
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+func (d *tDigest) add(x float64) {
+	d.buffer = append(d.buffer, centroid{mean: x, weight: 1})
+	d.count++
+	d.min = math.Min(d.min, x)
+	d.max = math.Max(d.max, x)
+	if len(d.buffer) == cap(d.buffer) {
+		d.merge()
+	}
+}
+
+// scale is the k2 scale function, which maps quantile q to an index
+// growing like log(q / (1-q)), normalised so that a digest of n numbers
+// keeps about compression centroids
+func (d *tDigest) scale(q float64) float64 {
+	return d.compression / d.normalizer() * math.Log(q/(1-q))
+}
+
+// scaleInverse maps index k back to a quantile
+func (d *tDigest) scaleInverse(k float64) float64 {
+	return 1 / (1 + math.Exp(-k*d.normalizer()/d.compression))
+}
+
+func (d *tDigest) normalizer() float64 {
+	return 4*math.Log(math.Max(d.count/d.compression, 1)) + 24
+}
+
+// merge folds the buffer into the centroids. A centroid grows while its
+// quantile range spans less than one unit of the scale function.
+func (d *tDigest) merge() {
+	if len(d.buffer) == 0 {
+		return
+	}
+
+	all := append(d.centroids, d.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := make([]centroid, 0, len(d.centroids)+1)
+	merged = append(merged, all[0])
+	before := 0.0 // weight of the centroids before the last of merged
+	// the first centroid stays a single number, as the scale of quantile 0 is -Inf
+	limit := 0.0
+	for _, c := range all[1:] {
+		last := &merged[len(merged)-1]
+		if before+last.weight+c.weight <= limit {
+			last.weight += c.weight
+			last.mean += (c.mean - last.mean) * c.weight / last.weight
+			continue
+		}
+		before += last.weight
+		limit = d.count * d.scaleInverse(d.scale(before/d.count)+1)
+		merged = append(merged, c)
+	}
+
+	d.centroids = merged
+	d.buffer = d.buffer[:0]
+}
+
+// quantile estimates the q-th quantile, q in [0, 1], interpolating between
+// the centres of neighbouring centroids
+func (d *tDigest) quantile(q float64) float64 {
+	d.merge()
+	cs := d.centroids
+	if len(cs) == 0 {
+		return math.NaN()
+	}
+	switch {
+	case q <= 0:
+		return d.min
+	case q >= 1:
+		return d.max
+	case len(cs) == 1:
+		return cs[0].mean
+	}
+
+	target := q * d.count
+	// from the minimum at weight 0 to the centre of the first centroid
+	if first := cs[0].weight / 2; target < first {
+		return d.min + (cs[0].mean-d.min)*target/first
+	}
+	before := 0.0
+	for i := 0; i < len(cs)-1; i++ {
+		left := before + cs[i].weight/2
+		right := before + cs[i].weight + cs[i+1].weight/2
+		if target < right {
+			return cs[i].mean + (cs[i+1].mean-cs[i].mean)*(target-left)/(right-left)
+		}
+		before += cs[i].weight
+	}
+	// from the centre of the last centroid to the maximum at the total weight
+	last := cs[len(cs)-1]
+	left := d.count - last.weight/2
+	return last.mean + (d.max-last.mean)*(target-left)/(d.count-left)
+}
+
+func (d *tDigest) sketch() *calculatorpb.QuantileSketch {
+	d.merge()
+	res := &calculatorpb.QuantileSketch{
+		Compression: d.compression,
+		Count:       uint64(d.count),
+		Min:         d.min,
+		Max:         d.max,
+	}
+	for _, c := range d.centroids {
+		res.Centroids = append(res.Centroids, &calculatorpb.Centroid{Mean: c.mean, Weight: uint64(c.weight)})
+	}
+	return res
+}
+
+// histogram counts numbers into bins of equal width, or of equal width on a
+// log scale, over [lower, upper)
+type histogram struct {
+	log          bool
+	lower, upper float64
+	closed       bool // whether upper belongs to the last bin
+	counts       []uint64
+	underflow    uint64
+	overflow     uint64
+}
+
+func newHistogram(log bool, lower, upper float64, bins int, closed bool) *histogram {
+	return &histogram{log: log, lower: lower, upper: upper, closed: closed, counts: make([]uint64, bins)}
+}
+
+func (h *histogram) add(x float64) {
+	if x < h.lower || (h.log && x <= 0) {
+		h.underflow++
+		return
+	}
+	if x > h.upper || (x == h.upper && !h.closed) {
+		h.overflow++
+		return
+	}
+
+	var pos float64
+	if h.upper > h.lower {
+		if h.log {
+			pos = math.Log(x/h.lower) / math.Log(h.upper/h.lower)
+		} else {
+			pos = (x - h.lower) / (h.upper - h.lower)
+		}
+	}
+	i := int(pos * float64(len(h.counts)))
+	if i >= len(h.counts) {
+		i = len(h.counts) - 1
+	}
+	// rounding may put a number at the edge of a bin into its neighbour
+	if i > 0 && x < h.edge(i) {
+		i--
+	} else if i < len(h.counts)-1 && x >= h.edge(i+1) {
+		i++
+	}
+	h.counts[i]++
+}
+
+// edge returns the lower edge of bin k, or the upper edge of the range for k == len(counts).
+// Inner edges are rounded to 15 significant digits, so 1 to 10000 in 4 log
+// scale bins has an edge at 10 rather than 10.000000000000002.
+func (h *histogram) edge(k int) float64 {
+	n := len(h.counts)
+	switch {
+	case k == 0:
+		return h.lower
+	case k == n:
+		return h.upper
+	case h.log:
+		return roundSignificant(h.lower * math.Pow(h.upper/h.lower, float64(k)/float64(n)))
+	}
+	return roundSignificant(h.lower + (h.upper-h.lower)*float64(k)/float64(n))
+}
+
+func (h *histogram) bins() []*calculatorpb.HistogramBin {
+	bins := make([]*calculatorpb.HistogramBin, len(h.counts))
+	for k, count := range h.counts {
+		bins[k] = &calculatorpb.HistogramBin{Lower: h.edge(k), Upper: h.edge(k + 1), Count: count}
+	}
+	return bins
+}
+
+// histogramBuilder counts the numbers as they arrive when the range of the
+// bins is known, and otherwise keeps them until the stream ends
+type histogramBuilder struct {
+	cfg    *calculatorpb.HistogramConfig
+	hist   *histogram // nil while the numbers are kept
+	values []float64
+	digest *tDigest
+}
+
+func newHistogramBuilder(cfg *calculatorpb.HistogramConfig) (*histogramBuilder, error) {
+	if _, ok := calculatorpb.HistogramBinning_name[int32(cfg.GetBinning())]; !ok {
+		return nil, fmt.Errorf("unsupported binning %v", cfg.GetBinning())
+	}
+	lower, upper := cfg.GetLower(), cfg.GetUpper()
+	if math.IsNaN(lower) || math.IsInf(lower, 0) || math.IsNaN(upper) || math.IsInf(upper, 0) {
+		return nil, fmt.Errorf("the range [%v, %v) is not finite", lower, upper)
+	}
+	ranged := lower != 0 || upper != 0
+	if ranged && lower >= upper {
+		return nil, fmt.Errorf("lower %v must be below upper %v", lower, upper)
+	}
+	if cfg.GetBinCount() > maxHistogramBins {
+		return nil, fmt.Errorf("%d bins, at most %d are supported", cfg.GetBinCount(), maxHistogramBins)
+	}
+
+	bins := int(cfg.GetBinCount())
+	if bins == 0 {
+		bins = defaultHistogramBins
+	}
+	switch cfg.GetBinning() {
+	case calculatorpb.HistogramBinning_HISTOGRAM_BINNING_UNSPECIFIED, calculatorpb.HistogramBinning_HISTOGRAM_BINNING_FREEDMAN_DIACONIS:
+		if ranged || cfg.GetBinCount() != 0 {
+			return nil, errors.New("Freedman-Diaconis bins span the numbers, lower, upper and bin_count must not be set")
+		}
+	case calculatorpb.HistogramBinning_HISTOGRAM_BINNING_LOG_SCALE:
+		if ranged && lower <= 0 {
+			return nil, fmt.Errorf("lower %v of log scale bins must be positive", lower)
+		}
+	}
+
+	if err := validatePercentiles(cfg.GetPercentiles()); err != nil {
+		return nil, err
+	}
+	compression := cfg.GetCompression()
+	if compression == 0 {
+		compression = defaultDigestCompression
+	}
+	if math.IsNaN(compression) || compression < minDigestCompression || compression > maxDigestCompression {
+		return nil, fmt.Errorf("compression %v is not in [%d, %d]", compression, minDigestCompression, maxDigestCompression)
+	}
+
+	b := &histogramBuilder{cfg: cfg, digest: newTDigest(compression)}
+	if ranged {
+		log := cfg.GetBinning() == calculatorpb.HistogramBinning_HISTOGRAM_BINNING_LOG_SCALE
+		b.hist = newHistogram(log, lower, upper, bins, false)
+	}
+	return b, nil
+}
+
+func (b *histogramBuilder) add(x float64) error {
+	if b.hist != nil {
+		b.hist.add(x)
+	} else {
+		if len(b.values) == maxStatisticsValues {
+			return status.Errorf(codes.ResourceExhausted, fmt.Sprintf("at most %d numbers are supported without a range, set lower and upper", maxStatisticsValues))
+		}
+		b.values = append(b.values, x)
+	}
+	b.digest.add(x)
+	return nil
+}
+
+// rangeHistogram bins the kept numbers over the range they span
+func (b *histogramBuilder) rangeHistogram() *histogram {
+	sorted := b.values
+	sort.Float64s(sorted)
+	min, max := sorted[0], sorted[len(sorted)-1]
+
+	bins := int(b.cfg.GetBinCount())
+	if bins == 0 {
+		bins = defaultHistogramBins
+	}
+	switch b.cfg.GetBinning() {
+	case calculatorpb.HistogramBinning_HISTOGRAM_BINNING_LOG_SCALE:
+		// log scale bins start at the smallest positive number
+		first := sort.Search(len(sorted), func(i int) bool { return sorted[i] > 0 })
+		if first == len(sorted) {
+			// no bins, every number is below the first positive one
+			h := newHistogram(true, 1, 1, 0, true)
+			h.underflow = uint64(len(sorted))
+			return h
+		}
+		min = sorted[first]
+	case calculatorpb.HistogramBinning_HISTOGRAM_BINNING_UNSPECIFIED, calculatorpb.HistogramBinning_HISTOGRAM_BINNING_FREEDMAN_DIACONIS:
+		bins = freedmanDiaconisBins(sorted)
+	}
+	if min == max {
+		bins = 1
+	}
+
+	h := newHistogram(b.cfg.GetBinning() == calculatorpb.HistogramBinning_HISTOGRAM_BINNING_LOG_SCALE, min, max, bins, true)
+	for _, x := range sorted {
+		h.add(x)
+	}
+	return h
+}
+
+// freedmanDiaconisBins returns how many bins 2 IQR / n^(1/3) wide cover the
+// sorted numbers, falling back to Sturges' rule when the IQR is 0
+func freedmanDiaconisBins(sorted []float64) int {
+	n := float64(len(sorted))
+	span := sorted[len(sorted)-1] - sorted[0]
+	if span == 0 {
+		return 1
+	}
+
+	iqr := percentile(sorted, 75) - percentile(sorted, 25)
+	bins := math.Ceil(math.Log2(n)) + 1
+	if iqr > 0 {
+		bins = math.Ceil(span / (freedmanDiaconisIQRFactor * iqr / math.Cbrt(n)))
+	}
+	return int(math.Max(1, math.Min(bins, maxHistogramBins)))
+}
+
+func (b *histogramBuilder) result() *calculatorpb.BuildHistogramResponse {
+	h := b.hist
+	if h == nil {
+		h = b.rangeHistogram()
+	}
+
+	res := &calculatorpb.BuildHistogramResponse{
+		Count:     uint64(b.digest.count),
+		Min:       b.digest.min,
+		Max:       b.digest.max,
+		Bins:      h.bins(),
+		Underflow: h.underflow,
+		Overflow:  h.overflow,
+		Sketch:    b.digest.sketch(),
+	}
+	percentiles := b.cfg.GetPercentiles()
+	if len(percentiles) == 0 {
+		percentiles = defaultHistogramPercentiles
+	}
+	for _, p := range percentiles {
+		res.Percentiles = append(res.Percentiles, &calculatorpb.Percentile{
+			Percentile: p,
+			Value:      b.digest.quantile(p / 100),
+		})
+	}
+	return res
+}
+
+func (*server) BuildHistogram(stream calculatorpb.CalculatorService_BuildHistogramServer) error {
+	fmt.Println("Received BuildHistogram RPC")
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return invalidArgument("config", errors.New("the stream is empty, the first message must configure the histogram"))
+	}
+	if err != nil {
+		return err
+	}
+	cfg := req.GetConfig()
+	if cfg == nil {
+		return invalidArgument("config", errors.New("the first message must configure the histogram"))
+	}
+	b, err := newHistogramBuilder(cfg)
+	if err != nil {
+		return invalidArgument("config", err)
+	}
+
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		payload, ok := req.GetPayload().(*calculatorpb.BuildHistogramRequest_Number)
+		if !ok {
+			return invalidArgument("number", errors.New("only the first message may configure the histogram"))
+		}
+		x := payload.Number
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return invalidArgument("number", fmt.Errorf("number %v is not finite", x))
+		}
+		if err := b.add(x); err != nil {
+			return err
+		}
+	}
+
+	if b.digest.count == 0 {
+		return invalidArgument("number", errors.New("no numbers were sent, the histogram of an empty stream is undefined"))
+	}
+	return stream.SendAndClose(b.result())
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// integerRange is the range of the numbers of an IntegerType
+type integerRange struct {
+	name     string
+	min, max int64
+}
+
+func integerTypeRange(t calculatorpb.IntegerType) (integerRange, error) {
+	switch t {
+	case calculatorpb.IntegerType_INTEGER_TYPE_INT32:
+		return integerRange{"int32", math.MinInt32, math.MaxInt32}, nil
+	case calculatorpb.IntegerType_INTEGER_TYPE_UNSPECIFIED, calculatorpb.IntegerType_INTEGER_TYPE_INT64:
+		return integerRange{"int64", math.MinInt64, math.MaxInt64}, nil
+	}
+	return integerRange{}, fmt.Errorf("unsupported integer type %v", t)
+}
+
+func (r integerRange) check(x int64) error {
+	if x < r.min || x > r.max {
+		return fmt.Errorf("%d is outside the %v range [%d, %d]", x, r.name, r.min, r.max)
+	}
+	return nil
+}
+
+// integerOperators are the symbols of the operations, for messages
+var integerOperators = map[calculatorpb.IntegerOperation]string{
+	calculatorpb.IntegerOperation_INTEGER_OPERATION_ADD:      "+",
+	calculatorpb.IntegerOperation_INTEGER_OPERATION_SUBTRACT: "-",
+	calculatorpb.IntegerOperation_INTEGER_OPERATION_MULTIPLY: "*",
+	calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE:   "/",
+	calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER:    "^",
+}
+
+// integerPower returns x^y, where y may only be negative for x = 1 or -1.
+// Results of more than 64 bits are returned as nil with their sign, as they
+// overflow any integer type.
+func integerPower(x, y int64) (res *big.Int, sign int) {
+	switch {
+	case x == 0 && y > 0:
+		return new(big.Int), 0
+	case x == 1 || y == 0:
+		return big.NewInt(1), 1
+	case x == -1:
+		if y%2 != 0 {
+			return big.NewInt(-1), -1
+		}
+		return big.NewInt(1), 1
+	}
+
+	sign = 1
+	if x < 0 && y%2 != 0 {
+		sign = -1
+	}
+	// |x| >= 2, so 64 or more factors have at least 64 bits
+	if y >= 64 {
+		return nil, sign
+	}
+	return new(big.Int).Exp(big.NewInt(x), big.NewInt(y), nil), sign
+}
+
+// integerOverflowError builds an OUT_OF_RANGE error for a result beyond r,
+// with an ErrorInfo detail holding the bounds and the exact result when known
+func integerOverflowError(expression string, res *big.Int, sign int, r integerRange) error {
+	metadata := map[string]string{
+		"type": r.name,
+		"min":  strconv.FormatInt(r.min, 10),
+		"max":  strconv.FormatInt(r.max, 10),
+	}
+	if res != nil {
+		expression += " = " + res.String()
+		metadata["result"] = res.String()
+	}
+	msg := fmt.Sprintf("%v is above the %v maximum %d", expression, r.name, r.max)
+	if sign < 0 {
+		msg = fmt.Sprintf("%v is below the %v minimum %d", expression, r.name, r.min)
+	}
+
+	st := status.New(codes.OutOfRange, fmt.Sprintf("Integer overflow: %v", msg))
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "INTEGER_OVERFLOW",
+		Domain:   "calculator",
+		Metadata: metadata,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (*server) IntegerArithmetic(ctx context.Context, in *calculatorpb.IntegerArithmeticRequest) (*calculatorpb.IntegerArithmeticResponse, error) {
+	fmt.Printf("Received IntegerArithmetic RPC: %v\n", in)
+
+	r, err := integerTypeRange(in.GetType())
+	if err != nil {
+		return nil, invalidArgument("type", err)
+	}
+	a, b := in.GetFirstNumber(), in.GetSecondNumber()
+	if err := r.check(a); err != nil {
+		return nil, invalidArgument("first_number", err)
+	}
+	if err := r.check(b); err != nil {
+		return nil, invalidArgument("second_number", err)
+	}
+	op, ok := integerOperators[in.GetOperation()]
+	if !ok {
+		return nil, invalidArgument("operation", fmt.Errorf("unsupported operation %v", in.GetOperation()))
+	}
+	expression := fmt.Sprintf("%d %v %d", a, op, b)
+	if op == "^" {
+		expression = fmt.Sprintf("%d^%d", a, b)
+		if a < 0 {
+			expression = fmt.Sprintf("(%d)^%d", a, b)
+		}
+	}
+
+	// the exact result has at most 128 bits, except for powers
+	x, y := big.NewInt(a), big.NewInt(b)
+	var res *big.Int
+	switch in.GetOperation() {
+	case calculatorpb.IntegerOperation_INTEGER_OPERATION_ADD:
+		res = new(big.Int).Add(x, y)
+	case calculatorpb.IntegerOperation_INTEGER_OPERATION_SUBTRACT:
+		res = new(big.Int).Sub(x, y)
+	case calculatorpb.IntegerOperation_INTEGER_OPERATION_MULTIPLY:
+		res = new(big.Int).Mul(x, y)
+	case calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE:
+		if b == 0 {
+			return nil, invalidArgument("second_number", errors.New("division by zero"))
+		}
+		res = new(big.Int).Quo(x, y)
+	case calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER:
+		if b < 0 && a == 0 {
+			return nil, invalidArgument("second_number", errors.New("zero cannot be raised to a negative power"))
+		}
+		if b < 0 && a != 1 && a != -1 {
+			return nil, invalidArgument("second_number", fmt.Errorf("%v is not an integer", expression))
+		}
+		var sign int
+		if res, sign = integerPower(a, b); res == nil {
+			return nil, integerOverflowError(expression, nil, sign, r)
+		}
+	}
+
+	if !res.IsInt64() || r.check(res.Int64()) != nil {
+		return nil, integerOverflowError(expression, res, res.Sign(), r)
+	}
+	return &calculatorpb.IntegerArithmeticResponse{
+		Result: res.Int64(),
+	}, nil
+}
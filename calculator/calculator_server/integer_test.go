@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+func TestIntegerArithmetic(t *testing.T) {
+	tests := []struct {
+		op   calculatorpb.IntegerOperation
+		a, b int64
+		typ  calculatorpb.IntegerType
+		want int64
+	}{
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_ADD, math.MaxInt32 - 1, 1, calculatorpb.IntegerType_INTEGER_TYPE_INT32, math.MaxInt32},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_SUBTRACT, math.MinInt64 + 1, 1, calculatorpb.IntegerType_INTEGER_TYPE_INT64, math.MinInt64},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_MULTIPLY, -3, 7, calculatorpb.IntegerType_INTEGER_TYPE_UNSPECIFIED, -21},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE, -7, 2, calculatorpb.IntegerType_INTEGER_TYPE_INT64, -3},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, 2, 62, calculatorpb.IntegerType_INTEGER_TYPE_INT64, 1 << 62},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, -2, 63, calculatorpb.IntegerType_INTEGER_TYPE_INT64, math.MinInt64},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, -1, -3, calculatorpb.IntegerType_INTEGER_TYPE_INT32, -1},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, 0, 0, calculatorpb.IntegerType_INTEGER_TYPE_INT32, 1},
+	}
+	for _, tt := range tests {
+		in := &calculatorpb.IntegerArithmeticRequest{Operation: tt.op, FirstNumber: tt.a, SecondNumber: tt.b, Type: tt.typ}
+		res, err := (&server{}).IntegerArithmetic(context.Background(), in)
+		if err != nil {
+			t.Errorf("IntegerArithmetic(%v) failed: %v", in, err)
+			continue
+		}
+		if res.GetResult() != tt.want {
+			t.Errorf("IntegerArithmetic(%v) = %d, want %d", in, res.GetResult(), tt.want)
+		}
+	}
+}
+
+func TestIntegerArithmeticErrors(t *testing.T) {
+	tests := []struct {
+		op     calculatorpb.IntegerOperation
+		a, b   int64
+		typ    calculatorpb.IntegerType
+		code   codes.Code
+		result string // the exact result in the ErrorInfo of an overflow, when known
+	}{
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_ADD, math.MaxInt32, 1, calculatorpb.IntegerType_INTEGER_TYPE_INT32, codes.OutOfRange, "2147483648"},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_SUBTRACT, math.MinInt64, 1, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.OutOfRange, "-9223372036854775809"},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_MULTIPLY, math.MaxInt64, 2, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.OutOfRange, "18446744073709551614"},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE, math.MinInt64, -1, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.OutOfRange, "9223372036854775808"},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, 3, 1000, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.OutOfRange, ""},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_DIVIDE, 1, 0, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.InvalidArgument, ""},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_POWER, 2, -1, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.InvalidArgument, ""},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_ADD, math.MaxInt32 + 1, 0, calculatorpb.IntegerType_INTEGER_TYPE_INT32, codes.InvalidArgument, ""},
+		{calculatorpb.IntegerOperation_INTEGER_OPERATION_UNSPECIFIED, 1, 1, calculatorpb.IntegerType_INTEGER_TYPE_INT64, codes.InvalidArgument, ""},
+	}
+	for _, tt := range tests {
+		in := &calculatorpb.IntegerArithmeticRequest{Operation: tt.op, FirstNumber: tt.a, SecondNumber: tt.b, Type: tt.typ}
+		_, err := (&server{}).IntegerArithmetic(context.Background(), in)
+		st := status.Convert(err)
+		if st.Code() != tt.code {
+			t.Errorf("IntegerArithmetic(%v) = %v, want code %v", in, err, tt.code)
+			continue
+		}
+		if tt.code == codes.OutOfRange {
+			checkOverflowInfo(t, st, tt.result)
+		}
+	}
+}
+
+func TestSumOverflow(t *testing.T) {
+	tests := []struct {
+		a, b   int32
+		result string
+	}{
+		{math.MaxInt32, 1, "2147483648"},
+		{math.MinInt32, -1, "-2147483649"},
+	}
+	for _, tt := range tests {
+		_, err := (&server{}).Sum(context.Background(), &calculatorpb.SumRequest{FirstNumber: tt.a, SecondNumber: tt.b})
+		st := status.Convert(err)
+		if st.Code() != codes.OutOfRange {
+			t.Errorf("Sum(%d, %d) = %v, want code %v", tt.a, tt.b, err, codes.OutOfRange)
+			continue
+		}
+		checkOverflowInfo(t, st, tt.result)
+	}
+
+	res, err := (&server{}).Sum(context.Background(), &calculatorpb.SumRequest{FirstNumber: math.MinInt32, SecondNumber: math.MaxInt32})
+	if err != nil || res.GetSumResult() != -1 {
+		t.Errorf("Sum(MinInt32, MaxInt32) = %v, %v, want -1", res, err)
+	}
+}
+
+// checkOverflowInfo checks the ErrorInfo detail of an integer overflow
+func checkOverflowInfo(t *testing.T, st *status.Status, result string) {
+	t.Helper()
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			if info.GetReason() != "INTEGER_OVERFLOW" || info.GetMetadata()["result"] != result {
+				t.Errorf("%v: ErrorInfo %v, want reason INTEGER_OVERFLOW and result %q", st.Message(), info, result)
+			}
+			return
+		}
+	}
+	t.Errorf("%v: no ErrorInfo detail", st.Message())
+}
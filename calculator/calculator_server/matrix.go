@@ -0,0 +1,391 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// maxMatrixElements bounds the size of an operand, 32 MB of float64
+const maxMatrixElements = 1 << 22
+
+// matrix is a dense row-major matrix
+type matrix struct {
+	rows, cols int
+	data       []float64
+}
+
+func newMatrix(rows, cols int) *matrix {
+	return &matrix{rows: rows, cols: cols, data: make([]float64, rows*cols)}
+}
+
+func (m *matrix) at(i, j int) float64     { return m.data[i*m.cols+j] }
+func (m *matrix) set(i, j int, v float64) { m.data[i*m.cols+j] = v }
+func (m *matrix) row(i int) []float64     { return m.data[i*m.cols : (i+1)*m.cols] }
+
+// appendRow adds a row to m, which must have the length of the previous rows
+func (m *matrix) appendRow(values []float64) error {
+	if m.rows > 0 && len(values) != m.cols {
+		return fmt.Errorf("row %d has %d columns, the previous rows have %d", m.rows+1, len(values), m.cols)
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("row %d is empty", m.rows+1)
+	}
+	if len(m.data)+len(values) > maxMatrixElements {
+		return fmt.Errorf("matrices of more than %d elements are not supported", maxMatrixElements)
+	}
+	if err := checkFinite(values); err != nil {
+		return fmt.Errorf("row %d: %v", m.rows+1, err)
+	}
+	m.cols = len(values)
+	m.rows++
+	m.data = append(m.data, values...)
+	return nil
+}
+
+func checkFinite(values []float64) error {
+	for j, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("value %d is %v", j+1, v)
+		}
+	}
+	return nil
+}
+
+func matrixFromProto(pb *calculatorpb.Matrix) (*matrix, error) {
+	m := &matrix{}
+	for _, row := range pb.GetRows() {
+		if err := m.appendRow(row.GetValues()); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *matrix) toProto() *calculatorpb.Matrix {
+	pb := &calculatorpb.Matrix{}
+	for i := 0; i < m.rows; i++ {
+		pb.Rows = append(pb.Rows, &calculatorpb.Vector{Values: m.row(i)})
+	}
+	return pb
+}
+
+func matrixAdd(a, b *matrix) (*matrix, error) {
+	if a.rows != b.rows || a.cols != b.cols {
+		return nil, fmt.Errorf("cannot add a %dx%d matrix to a %dx%d matrix", b.rows, b.cols, a.rows, a.cols)
+	}
+	res := newMatrix(a.rows, a.cols)
+	for i := range res.data {
+		res.data[i] = a.data[i] + b.data[i]
+	}
+	return res, nil
+}
+
+// matrixMultiply returns a * b, where a has as many columns as b has rows
+func matrixMultiply(ctx context.Context, a, b *matrix) (*matrix, error) {
+	res := newMatrix(a.rows, b.cols)
+	for i := 0; i < a.rows; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// i-k-j order walks both b and the result along their rows
+		out := res.row(i)
+		for k := 0; k < a.cols; k++ {
+			aik := a.at(i, k)
+			for j, bkj := range b.row(k) {
+				out[j] += aik * bkj
+			}
+		}
+	}
+	return res, nil
+}
+
+func matrixTranspose(a *matrix) *matrix {
+	res := newMatrix(a.cols, a.rows)
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.cols; j++ {
+			res.set(j, i, a.at(i, j))
+		}
+	}
+	return res
+}
+
+// luDecomposition holds PA = LU, with L below the diagonal of lu (its unit
+// diagonal left out) and U on and above it
+type luDecomposition struct {
+	lu       *matrix
+	perm     []int // row i of PA is row perm[i] of A
+	sign     float64
+	singular bool
+}
+
+// luDecompose factors the square matrix a with partial pivoting. A pivot
+// that is negligible next to the largest element of a marks it singular.
+func luDecompose(ctx context.Context, a *matrix) (*luDecomposition, error) {
+	n := a.rows
+	lu := &matrix{rows: n, cols: n, data: append([]float64(nil), a.data...)}
+	d := &luDecomposition{lu: lu, perm: make([]int, n), sign: 1}
+	for i := range d.perm {
+		d.perm[i] = i
+	}
+
+	scale := 0.0
+	for _, v := range a.data {
+		scale = math.Max(scale, math.Abs(v))
+	}
+	tolerance := scale * float64(n) * 0x1p-52
+
+	for k := 0; k < n; k++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu.at(i, k)) > math.Abs(lu.at(p, k)) {
+				p = i
+			}
+		}
+		if math.Abs(lu.at(p, k)) <= tolerance {
+			d.singular = true
+			continue
+		}
+		if p != k {
+			rowP, rowK := lu.row(p), lu.row(k)
+			for j := range rowK {
+				rowP[j], rowK[j] = rowK[j], rowP[j]
+			}
+			d.perm[p], d.perm[k] = d.perm[k], d.perm[p]
+			d.sign = -d.sign
+		}
+
+		pivot := lu.row(k)
+		for i := k + 1; i < n; i++ {
+			row := lu.row(i)
+			row[k] /= pivot[k]
+			for j := k + 1; j < n; j++ {
+				row[j] -= row[k] * pivot[j]
+			}
+		}
+	}
+	return d, nil
+}
+
+func (d *luDecomposition) determinant() float64 {
+	if d.singular {
+		return 0
+	}
+	det := d.sign
+	for i := 0; i < d.lu.rows; i++ {
+		det *= d.lu.at(i, i)
+	}
+	return det
+}
+
+// solve returns the x with A x = b by forward and back substitution
+func (d *luDecomposition) solve(b []float64) []float64 {
+	n := d.lu.rows
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[i] = b[d.perm[i]]
+		row := d.lu.row(i)
+		for j := 0; j < i; j++ {
+			x[i] -= row[j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		row := d.lu.row(i)
+		for j := i + 1; j < n; j++ {
+			x[i] -= row[j] * x[j]
+		}
+		x[i] /= row[i]
+	}
+	return x
+}
+
+func matrixInverse(ctx context.Context, d *luDecomposition) (*matrix, error) {
+	n := d.lu.rows
+	res := newMatrix(n, n)
+	unit := make([]float64, n)
+	for j := 0; j < n; j++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		unit[j] = 1
+		for i, v := range d.solve(unit) {
+			res.set(i, j, v)
+		}
+		unit[j] = 0
+	}
+	return res, nil
+}
+
+func checkSquare(a *matrix) error {
+	if a.rows != a.cols {
+		return fmt.Errorf("the matrix is %dx%d, not square", a.rows, a.cols)
+	}
+	return nil
+}
+
+// computeMatrix runs op on the operands that it takes, which must not be empty
+func computeMatrix(ctx context.Context, op calculatorpb.MatrixOperation, first, second *matrix, vector []float64) (*calculatorpb.ComputeMatrixResponse, error) {
+	if first.rows == 0 {
+		return nil, invalidArgument("first", errors.New("the matrix is empty"))
+	}
+	if (op == calculatorpb.MatrixOperation_MATRIX_OPERATION_ADD || op == calculatorpb.MatrixOperation_MATRIX_OPERATION_MULTIPLY) && second.rows == 0 {
+		return nil, invalidArgument("second", errors.New("the matrix is empty"))
+	}
+
+	var res *matrix
+	var err error
+	switch op {
+	case calculatorpb.MatrixOperation_MATRIX_OPERATION_ADD:
+		if res, err = matrixAdd(first, second); err != nil {
+			return nil, invalidArgument("second", err)
+		}
+	case calculatorpb.MatrixOperation_MATRIX_OPERATION_MULTIPLY:
+		if first.cols != second.rows {
+			return nil, invalidArgument("second", fmt.Errorf("cannot multiply a %dx%d matrix by a %dx%d matrix", first.rows, first.cols, second.rows, second.cols))
+		}
+		// the operands are bounded, but a column times a row can be far larger
+		if first.rows*second.cols > maxMatrixElements {
+			return nil, invalidArgument("second", fmt.Errorf("the %dx%d product has more than %d elements", first.rows, second.cols, maxMatrixElements))
+		}
+		res, err = matrixMultiply(ctx, first, second)
+	case calculatorpb.MatrixOperation_MATRIX_OPERATION_TRANSPOSE:
+		res = matrixTranspose(first)
+	case calculatorpb.MatrixOperation_MATRIX_OPERATION_DETERMINANT,
+		calculatorpb.MatrixOperation_MATRIX_OPERATION_INVERSE,
+		calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE:
+		return computeLU(ctx, op, first, vector)
+	default:
+		return nil, invalidArgument("operation", fmt.Errorf("unsupported operation %v", op))
+	}
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	return &calculatorpb.ComputeMatrixResponse{
+		Result: &calculatorpb.ComputeMatrixResponse_Matrix{Matrix: res.toProto()},
+	}, nil
+}
+
+// computeLU runs the operations that go through the LU decomposition of a
+func computeLU(ctx context.Context, op calculatorpb.MatrixOperation, a *matrix, vector []float64) (*calculatorpb.ComputeMatrixResponse, error) {
+	if err := checkSquare(a); err != nil {
+		return nil, invalidArgument("first", err)
+	}
+	if op == calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE {
+		if len(vector) != a.rows {
+			return nil, invalidArgument("vector", fmt.Errorf("the vector has %d values, the matrix has %d rows", len(vector), a.rows))
+		}
+		if err := checkFinite(vector); err != nil {
+			return nil, invalidArgument("vector", err)
+		}
+	}
+
+	d, err := luDecompose(ctx, a)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	if op == calculatorpb.MatrixOperation_MATRIX_OPERATION_DETERMINANT {
+		return &calculatorpb.ComputeMatrixResponse{
+			Result: &calculatorpb.ComputeMatrixResponse_Determinant{Determinant: d.determinant()},
+		}, nil
+	}
+	if d.singular {
+		if op == calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE {
+			return nil, status.Errorf(codes.FailedPrecondition, "the matrix is singular, the system has no unique solution")
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "the matrix is singular, it has no inverse")
+	}
+
+	if op == calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE {
+		return &calculatorpb.ComputeMatrixResponse{
+			Result: &calculatorpb.ComputeMatrixResponse_Vector{Vector: &calculatorpb.Vector{Values: d.solve(vector)}},
+		}, nil
+	}
+	inverse, err := matrixInverse(ctx, d)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	return &calculatorpb.ComputeMatrixResponse{
+		Result: &calculatorpb.ComputeMatrixResponse_Matrix{Matrix: inverse.toProto()},
+	}, nil
+}
+
+func (*server) ComputeMatrix(ctx context.Context, in *calculatorpb.ComputeMatrixRequest) (*calculatorpb.ComputeMatrixResponse, error) {
+	fmt.Printf("Received ComputeMatrix RPC: %v\n", in.GetOperation())
+
+	first, err := matrixFromProto(in.GetFirst())
+	if err != nil {
+		return nil, invalidArgument("first", err)
+	}
+	second, err := matrixFromProto(in.GetSecond())
+	if err != nil {
+		return nil, invalidArgument("second", err)
+	}
+
+	return computeMatrix(ctx, in.GetOperation(), first, second, in.GetVector().GetValues())
+}
+
+func (*server) UploadMatrix(stream calculatorpb.CalculatorService_UploadMatrixServer) error {
+	fmt.Println("Received UploadMatrix RPC")
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return invalidArgument("operation", errors.New("no operation was sent"))
+	}
+	if err != nil {
+		return err
+	}
+	payload, ok := req.GetPayload().(*calculatorpb.UploadMatrixRequest_Operation)
+	if !ok {
+		return invalidArgument("operation", errors.New("the first message must set the operation"))
+	}
+	op := payload.Operation
+
+	first, second := &matrix{}, &matrix{}
+	vector := []float64{}
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch payload := req.GetPayload().(type) {
+		case *calculatorpb.UploadMatrixRequest_FirstRow:
+			if err := first.appendRow(payload.FirstRow.GetValues()); err != nil {
+				return invalidArgument("first_row", err)
+			}
+		case *calculatorpb.UploadMatrixRequest_SecondRow:
+			if err := second.appendRow(payload.SecondRow.GetValues()); err != nil {
+				return invalidArgument("second_row", err)
+			}
+		case *calculatorpb.UploadMatrixRequest_Vector:
+			if len(vector)+len(payload.Vector.GetValues()) > maxMatrixElements {
+				return invalidArgument("vector", fmt.Errorf("vectors of more than %d values are not supported", maxMatrixElements))
+			}
+			vector = append(vector, payload.Vector.GetValues()...)
+		default:
+			return invalidArgument("operation", errors.New("only the first message may set the operation"))
+		}
+	}
+
+	res, err := computeMatrix(stream.Context(), op, first, second, vector)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(res)
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+func matrixOf(rows ...[]float64) *calculatorpb.Matrix {
+	m := &calculatorpb.Matrix{}
+	for _, row := range rows {
+		m.Rows = append(m.Rows, &calculatorpb.Vector{Values: row})
+	}
+	return m
+}
+
+func TestComputeMatrix(t *testing.T) {
+	a := matrixOf([]float64{1, 2}, []float64{3, 4})
+	tests := []struct {
+		name       string
+		in         *calculatorpb.ComputeMatrixRequest
+		wantMatrix [][]float64
+		wantVector []float64
+		wantDet    float64
+	}{
+		{
+			name:       "add",
+			in:         &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_ADD, First: a, Second: a},
+			wantMatrix: [][]float64{{2, 4}, {6, 8}},
+		},
+		{
+			name: "multiply",
+			in: &calculatorpb.ComputeMatrixRequest{
+				Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_MULTIPLY,
+				First:     a,
+				Second:    matrixOf([]float64{1}, []float64{1}),
+			},
+			wantMatrix: [][]float64{{3}, {7}},
+		},
+		{
+			name:       "transpose",
+			in:         &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_TRANSPOSE, First: matrixOf([]float64{1, 2, 3})},
+			wantMatrix: [][]float64{{1}, {2}, {3}},
+		},
+		{
+			name:    "determinant",
+			in:      &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_DETERMINANT, First: a},
+			wantDet: -2,
+		},
+		{
+			name:       "inverse",
+			in:         &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_INVERSE, First: a},
+			wantMatrix: [][]float64{{-2, 1}, {1.5, -0.5}},
+		},
+		{
+			name: "solve",
+			in: &calculatorpb.ComputeMatrixRequest{
+				Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE,
+				First:     a,
+				Vector:    &calculatorpb.Vector{Values: []float64{5, 11}},
+			},
+			wantVector: []float64{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		res, err := (&server{}).ComputeMatrix(context.Background(), tt.in)
+		if err != nil {
+			t.Errorf("%v: ComputeMatrix failed: %v", tt.name, err)
+			continue
+		}
+		switch {
+		case tt.wantMatrix != nil:
+			var got [][]float64
+			for _, row := range res.GetMatrix().GetRows() {
+				got = append(got, row.GetValues())
+			}
+			if !approxEqualRows(got, tt.wantMatrix) {
+				t.Errorf("%v: got %v, want %v", tt.name, got, tt.wantMatrix)
+			}
+		case tt.wantVector != nil:
+			if got := res.GetVector().GetValues(); !approxEqualRows([][]float64{got}, [][]float64{tt.wantVector}) {
+				t.Errorf("%v: got %v, want %v", tt.name, got, tt.wantVector)
+			}
+		default:
+			if got := res.GetDeterminant(); math.Abs(got-tt.wantDet) > 1e-9 {
+				t.Errorf("%v: got %v, want %v", tt.name, got, tt.wantDet)
+			}
+		}
+	}
+}
+
+func approxEqualRows(got, want [][]float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if len(got[i]) != len(want[i]) {
+			return false
+		}
+		for j := range got[i] {
+			if math.Abs(got[i][j]-want[i][j]) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestComputeMatrixErrors(t *testing.T) {
+	singular := matrixOf([]float64{1, 2}, []float64{2, 4})
+	column := make([][]float64, 3000)
+	for i := range column {
+		column[i] = []float64{1}
+	}
+	row := make([]float64, 3000)
+
+	tests := []struct {
+		name string
+		in   *calculatorpb.ComputeMatrixRequest
+		code codes.Code
+		msg  string
+	}{
+		{
+			name: "empty",
+			in:   &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_TRANSPOSE},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "ragged",
+			in:   &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_TRANSPOSE, First: matrixOf([]float64{1, 2}, []float64{3})},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "mismatched product",
+			in:   &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_MULTIPLY, First: singular, Second: matrixOf([]float64{1, 2, 3})},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "oversized product",
+			in:   &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_MULTIPLY, First: matrixOf(column...), Second: matrixOf(row)},
+			code: codes.InvalidArgument,
+			msg:  "Invalid second: the 3000x3000 product has more than 4194304 elements",
+		},
+		{
+			name: "not square",
+			in:   &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_DETERMINANT, First: matrixOf([]float64{1, 2})},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "singular inverse",
+			in:   &calculatorpb.ComputeMatrixRequest{Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_INVERSE, First: singular},
+			code: codes.FailedPrecondition,
+			msg:  "the matrix is singular, it has no inverse",
+		},
+		{
+			name: "singular system",
+			in: &calculatorpb.ComputeMatrixRequest{
+				Operation: calculatorpb.MatrixOperation_MATRIX_OPERATION_SOLVE,
+				First:     singular,
+				Vector:    &calculatorpb.Vector{Values: []float64{1, 2}},
+			},
+			code: codes.FailedPrecondition,
+			msg:  "the matrix is singular, the system has no unique solution",
+		},
+	}
+	for _, tt := range tests {
+		_, err := (&server{}).ComputeMatrix(context.Background(), tt.in)
+		st := status.Convert(err)
+		if st.Code() != tt.code {
+			t.Errorf("%v: got %v, want code %v", tt.name, err, tt.code)
+			continue
+		}
+		if tt.msg != "" && st.Message() != tt.msg {
+			t.Errorf("%v: got message %q, want %q", tt.name, st.Message(), tt.msg)
+		}
+	}
+}
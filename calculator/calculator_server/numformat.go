@@ -0,0 +1,568 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+const (
+	maxRoman          = 3999
+	defaultNumberBase = 10
+
+	// Both RPCs bound numbers by their bits rather than the length of their
+	// text, so that whatever FormatNumber writes ParseNumber reads back.
+	// Base 2 is the longest notation, one character per bit and a sign.
+	maxNumberBits       = 1 << 16
+	maxNumberDigits     = 19729 // decimal digits of a maxNumberBits-bit number
+	maxNumberTextLength = maxNumberBits + 64
+)
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+var romanValues = map[rune]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+
+// English number words, on the short scale
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tensWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleWords = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion",
+		"quintillion", "sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+	bigThousand = big.NewInt(1000)
+	// maxWordsNumber is the first number too large to write in words, 10^36
+	maxWordsNumber = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(3*len(scaleWords))), nil)
+)
+
+func formatRoman(n int) string {
+	var b strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			b.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return b.String()
+}
+
+// parseRoman reads a Roman numeral written the standard way, so IV but not
+// IIII, and reports the first character where the text leaves that form
+func parseRoman(text []rune, offset int) (*big.Int, error) {
+	if len(text) == 0 {
+		return nil, errors.New("the text is empty")
+	}
+
+	upper := make([]rune, len(text))
+	value := 0
+	for i, c := range text {
+		upper[i] = unicode.ToUpper(c)
+		if _, ok := romanValues[upper[i]]; !ok {
+			return nil, errorAt(offset+i+1, "%q is not a Roman numeral", c)
+		}
+	}
+	// read the value as loosely as possible, then compare with the standard form
+	for i, c := range upper {
+		v := romanValues[c]
+		if i+1 < len(upper) && v < romanValues[upper[i+1]] {
+			value -= v
+		} else {
+			value += v
+		}
+	}
+	if value < 1 {
+		value = 1
+	}
+	if value > maxRoman {
+		value = maxRoman
+	}
+
+	want := []rune(formatRoman(value))
+	for i, c := range upper {
+		if i >= len(want) || c != want[i] {
+			if value == maxRoman {
+				return nil, errorAt(offset+i+1, "unexpected %q, Roman numerals go up to %d, %v", text[i], maxRoman, string(want))
+			}
+			return nil, errorAt(offset+i+1, "unexpected %q, %d is written %v", text[i], value, string(want))
+		}
+	}
+	if len(upper) < len(want) {
+		return nil, errorAt(offset+len(text)+1, "the numeral ends early, %d is written %v", value, string(want))
+	}
+	return big.NewInt(int64(value)), nil
+}
+
+// formatScientific writes n as d.ddde±x with no trailing zeros in the
+// mantissa, so it reads back exactly
+func formatScientific(n *big.Int) string {
+	digits := new(big.Int).Abs(n).String()
+	sign := ""
+	if n.Sign() < 0 {
+		sign = "-"
+	}
+	exponent := len(digits) - 1
+	mantissa := strings.TrimRight(digits, "0")
+	if mantissa == "" {
+		return "0e0"
+	}
+	if len(mantissa) > 1 {
+		mantissa = mantissa[:1] + "." + mantissa[1:]
+	}
+	return fmt.Sprintf("%v%ve%d", sign, mantissa, exponent)
+}
+
+// parseScientific reads [-]digits[.digits][e[±]digits] that is an integer
+func parseScientific(text []rune, offset int) (*big.Int, error) {
+	if len(text) == 0 {
+		return nil, errors.New("the text is empty")
+	}
+
+	i := 0
+	negative := false
+	if text[i] == '-' || text[i] == '+' {
+		negative = text[i] == '-'
+		i++
+	}
+	mantissaStart := i
+	// scan reads a run of decimal digits
+	scan := func(what string) (string, error) {
+		start := i
+		for i < len(text) && text[i] >= '0' && text[i] <= '9' {
+			i++
+		}
+		if i == start {
+			if i == len(text) {
+				return "", errorAt(offset+i+1, "the text ends before the %v", what)
+			}
+			return "", errorAt(offset+i+1, "unexpected %q, expected a digit of the %v", text[i], what)
+		}
+		return string(text[start:i]), nil
+	}
+
+	whole, err := scan("mantissa")
+	if err != nil {
+		return nil, err
+	}
+	fraction := ""
+	if i < len(text) && text[i] == '.' {
+		i++
+		if fraction, err = scan("mantissa"); err != nil {
+			return nil, err
+		}
+	}
+	exponent := 0
+	if i < len(text) && (text[i] == 'e' || text[i] == 'E') {
+		i++
+		expSign := 1
+		if i < len(text) && (text[i] == '-' || text[i] == '+') {
+			if text[i] == '-' {
+				expSign = -1
+			}
+			i++
+		}
+		expCol := i
+		digits, err := scan("exponent")
+		if err != nil {
+			return nil, err
+		}
+		if len(digits) > 5 {
+			return nil, errorAt(offset+expCol+1, "exponent %v is out of range [%d, %d]", digits, -maxNumberDigits, maxNumberDigits)
+		}
+		exponent, _ = strconv.Atoi(digits)
+		exponent *= expSign
+		if exponent > maxNumberDigits || exponent < -maxNumberDigits {
+			return nil, errorAt(offset+expCol+1, "exponent %d is out of range [%d, %d]", exponent, -maxNumberDigits, maxNumberDigits)
+		}
+	}
+	if i < len(text) {
+		return nil, errorAt(offset+i+1, "unexpected %q after the number", text[i])
+	}
+
+	// the value is mantissa digits * 10^(exponent - fraction digits)
+	n, _ := new(big.Int).SetString(whole+fraction, 10)
+	shift := exponent - len(fraction)
+	if shift >= 0 {
+		n.Mul(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil))
+	} else {
+		// the last -shift digits must be zeros
+		digits := whole + fraction
+		for j := len(digits) + shift; j < len(digits); j++ {
+			if j >= 0 && digits[j] != '0' {
+				col := mantissaStart + j + 1
+				if j >= len(whole) {
+					col++ // the decimal point
+				}
+				return nil, errorAt(offset+col, "digit %q makes %v a fraction, not an integer", digits[j], string(text))
+			}
+		}
+		n.Quo(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-shift)), nil))
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// groupWords writes 1 to 999 in words: three hundred and forty-two
+func groupWords(n int) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100], "hundred")
+		n %= 100
+		if n > 0 {
+			parts = append(parts, "and")
+		}
+	}
+	switch {
+	case n >= 20 && n%10 != 0:
+		parts = append(parts, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	case n >= 20:
+		parts = append(parts, tensWords[n/10])
+	case n > 0:
+		parts = append(parts, smallNumberWords[n])
+	}
+	return strings.Join(parts, " ")
+}
+
+// formatWords writes n in British English words, one million two hundred
+// and three thousand and four
+func formatWords(n *big.Int) (string, error) {
+	abs := new(big.Int).Abs(n)
+	if abs.Cmp(maxWordsNumber) >= 0 {
+		return "", fmt.Errorf("%v is too large, numbers in words must be below 10^%d", n, 3*len(scaleWords))
+	}
+	if abs.Sign() == 0 {
+		return "zero", nil
+	}
+
+	// groups of three digits, least significant first
+	var groups []int
+	for rest := new(big.Int).Set(abs); rest.Sign() > 0; {
+		var group big.Int
+		rest.QuoRem(rest, bigThousand, &group)
+		groups = append(groups, int(group.Int64()))
+	}
+
+	var parts []string
+	if n.Sign() < 0 {
+		parts = append(parts, "minus")
+	}
+	for scale := len(groups) - 1; scale >= 0; scale-- {
+		group := groups[scale]
+		if group == 0 {
+			continue
+		}
+		// and joins a last group below 100 to the ones before it
+		if scale == 0 && group < 100 && len(groups) > 1 {
+			parts = append(parts, "and")
+		}
+		parts = append(parts, groupWords(group))
+		if scale > 0 {
+			parts = append(parts, scaleWords[scale])
+		}
+	}
+	return strings.Join(parts, " "), nil
+}
+
+type wordToken struct {
+	word string
+	col  int
+}
+
+// splitWords splits text at spaces, hyphens and commas and lowercases the words
+func splitWords(text []rune, offset int) []wordToken {
+	var words []wordToken
+	start := -1
+	for i := 0; i <= len(text); i++ {
+		sep := i == len(text) || unicode.IsSpace(text[i]) || text[i] == '-' || text[i] == ','
+		if sep && start >= 0 {
+			words = append(words, wordToken{word: strings.ToLower(string(text[start:i])), col: offset + start + 1})
+			start = -1
+		} else if !sep && start < 0 {
+			start = i
+		}
+	}
+	return words
+}
+
+func indexOf(words []string, word string) int {
+	for i, w := range words {
+		if w != "" && w == word {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseWords reads a number written in English words. It accepts any
+// capitalisation, hyphens, commas and "and", but the words must be in the
+// order numbers are said.
+func parseWords(text []rune, offset int) (*big.Int, error) {
+	words := splitWords(text, offset)
+	if len(words) == 0 {
+		return nil, errors.New("the text is empty")
+	}
+
+	negative := false
+	if words[0].word == "minus" || words[0].word == "negative" {
+		negative = true
+		words = words[1:]
+		if len(words) == 0 {
+			return nil, errorAt(offset+len(text)+1, "the text ends after the sign")
+		}
+	}
+	if words[0].word == "zero" {
+		if len(words) > 1 {
+			return nil, errorAt(words[1].col, "unexpected word %q after zero", words[1].word)
+		}
+		return new(big.Int), nil
+	}
+
+	total := new(big.Int)
+	group := 0 // the group of three digits being read
+	lastScale := len(scaleWords)
+	numbers := 0 // number words read, and may not end the text
+	for i, w := range words {
+		if small := indexOf(smallNumberWords, w.word); small > 0 {
+			// a unit needs an empty units place after no teen, a teen empty tens and units
+			if (small < 10 && (group%10 != 0 || group%100 >= 10 && group%100 < 20)) || (small >= 10 && group%100 != 0) {
+				return nil, errorAt(w.col, "unexpected word %q", w.word)
+			}
+			group += small
+			numbers++
+			continue
+		}
+		if tens := indexOf(tensWords, w.word); tens > 0 {
+			if group%100 != 0 {
+				return nil, errorAt(w.col, "unexpected word %q", w.word)
+			}
+			group += tens * 10
+			numbers++
+			continue
+		}
+
+		switch scale := indexOf(scaleWords, w.word); {
+		case w.word == "and":
+			if i == len(words)-1 {
+				return nil, errorAt(offset+len(text)+1, "the text ends after %q", w.word)
+			}
+			if numbers == 0 {
+				return nil, errorAt(w.col, "unexpected word %q", w.word)
+			}
+		case w.word == "hundred":
+			if group < 1 || group > 9 {
+				return nil, errorAt(w.col, "unexpected word %q", w.word)
+			}
+			group *= 100
+		case scale > 0:
+			if group == 0 || scale >= lastScale {
+				return nil, errorAt(w.col, "unexpected word %q", w.word)
+			}
+			var g big.Int
+			g.Exp(bigThousand, big.NewInt(int64(scale)), nil)
+			total.Add(total, g.Mul(&g, big.NewInt(int64(group))))
+			group = 0
+			lastScale = scale
+		case w.word == "zero" || w.word == "minus" || w.word == "negative":
+			return nil, errorAt(w.col, "unexpected word %q", w.word)
+		default:
+			return nil, errorAt(w.col, "unknown word %q", w.word)
+		}
+	}
+	if numbers == 0 {
+		return nil, errorAt(words[0].col, "unexpected word %q", words[0].word)
+	}
+
+	total.Add(total, big.NewInt(int64(group)))
+	if negative {
+		total.Neg(total)
+	}
+	return total, nil
+}
+
+// parsePositional reads [-]digits in base, with digits above 9 in either case
+func parsePositional(text []rune, offset int, base int) (*big.Int, error) {
+	if len(text) == 0 {
+		return nil, errors.New("the text is empty")
+	}
+
+	i := 0
+	negative := false
+	if text[0] == '-' || text[0] == '+' {
+		negative = text[0] == '-'
+		i++
+		if i == len(text) {
+			return nil, errorAt(offset+i+1, "the text ends after the sign")
+		}
+	}
+	n := new(big.Int)
+	b := big.NewInt(int64(base))
+	for ; i < len(text); i++ {
+		c := unicode.ToLower(text[i])
+		digit := base
+		switch {
+		case c >= '0' && c <= '9':
+			digit = int(c - '0')
+		case c >= 'a' && c <= 'z':
+			digit = int(c-'a') + 10
+		}
+		if digit >= base {
+			return nil, errorAt(offset+i+1, "%q is not a digit in base %d", text[i], base)
+		}
+		n.Mul(n, b)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// numberBase checks the base of a notation, where only positional notation has one
+func numberBase(notation calculatorpb.NumberNotation, base uint32) (int, error) {
+	if notation != calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL {
+		if base != 0 && base != defaultNumberBase {
+			return 0, fmt.Errorf("%v has no base, only positional notation does", notation)
+		}
+		return defaultNumberBase, nil
+	}
+	if base == 0 {
+		return defaultNumberBase, nil
+	}
+	if base < 2 || base > 36 {
+		return 0, fmt.Errorf("base %d is not in [2, 36]", base)
+	}
+	return int(base), nil
+}
+
+// parseFormatNumber reads the big_number of FormatNumber within the bounds of
+// this file rather than those of the BigNumber RPCs, so that any number
+// ParseNumber returns can be formatted again
+func parseFormatNumber(n *calculatorpb.BigNumber) (*big.Int, error) {
+	r, err := parseBigNumberWithin(n, maxNumberTextLength, maxNumberDigits)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%v is not an integer", r.RatString())
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+func (*server) FormatNumber(ctx context.Context, in *calculatorpb.FormatNumberRequest) (*calculatorpb.FormatNumberResponse, error) {
+	fmt.Printf("Received FormatNumber RPC: %v\n", in)
+
+	number, field := big.NewInt(in.GetNumber()), "number"
+	if in.GetBigNumber() != nil {
+		field = "big_number"
+		n, err := parseFormatNumber(in.GetBigNumber())
+		if err != nil {
+			return nil, invalidArgument(field, err)
+		}
+		number = n
+	}
+	if number.BitLen() > maxNumberBits {
+		return nil, invalidArgument(field, fmt.Errorf("number has %d bits, at most %d are supported", number.BitLen(), maxNumberBits))
+	}
+	notation := in.GetNotation()
+	if _, ok := calculatorpb.NumberNotation_name[int32(notation)]; !ok {
+		return nil, invalidArgument("notation", fmt.Errorf("unsupported notation %v", notation))
+	}
+	base, err := numberBase(notation, in.GetBase())
+	if err != nil {
+		return nil, invalidArgument("base", err)
+	}
+
+	var text string
+	switch notation {
+	case calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN:
+		if number.Sign() <= 0 || number.Cmp(big.NewInt(maxRoman)) > 0 {
+			return nil, invalidArgument(field, fmt.Errorf("%v has no Roman numeral, they go from 1 to %d", number, maxRoman))
+		}
+		text = formatRoman(int(number.Int64()))
+	case calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC:
+		text = formatScientific(number)
+	case calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS:
+		if text, err = formatWords(number); err != nil {
+			return nil, invalidArgument(field, err)
+		}
+	default:
+		text = number.Text(base)
+		if in.GetUppercase() {
+			text = strings.ToUpper(text)
+		}
+	}
+
+	return &calculatorpb.FormatNumberResponse{
+		Text: text,
+	}, nil
+}
+
+func (*server) ParseNumber(ctx context.Context, in *calculatorpb.ParseNumberRequest) (*calculatorpb.ParseNumberResponse, error) {
+	fmt.Printf("Received ParseNumber RPC: %v\n", in)
+
+	notation := in.GetNotation()
+	if _, ok := calculatorpb.NumberNotation_name[int32(notation)]; !ok {
+		return nil, invalidArgument("notation", fmt.Errorf("unsupported notation %v", notation))
+	}
+	base, err := numberBase(notation, in.GetBase())
+	if err != nil {
+		return nil, invalidArgument("base", err)
+	}
+	if len(in.GetText()) > maxNumberTextLength {
+		return nil, invalidArgument("text", fmt.Errorf("text has more than %d characters", maxNumberTextLength))
+	}
+
+	// surrounding spaces are ignored, columns still count from the start of the text
+	runes := []rune(in.GetText())
+	start, end := 0, len(runes)
+	for start < end && unicode.IsSpace(runes[start]) {
+		start++
+	}
+	for end > start && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+	text := runes[start:end]
+
+	var number *big.Int
+	switch notation {
+	case calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN:
+		number, err = parseRoman(text, start)
+	case calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC:
+		number, err = parseScientific(text, start)
+	case calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS:
+		number, err = parseWords(text, start)
+	default:
+		number, err = parsePositional(text, start, base)
+	}
+	if err != nil {
+		return nil, invalidArgument("text", err)
+	}
+	if number.BitLen() > maxNumberBits {
+		return nil, invalidArgument("text", fmt.Errorf("number has %d bits, at most %d are supported", number.BitLen(), maxNumberBits))
+	}
+
+	res := &calculatorpb.ParseNumberResponse{
+		BigNumber: formatBigInteger(number),
+	}
+	if number.IsInt64() {
+		res.Number = number.Int64()
+	}
+	return res, nil
+}
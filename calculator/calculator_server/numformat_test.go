@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		in   *calculatorpb.FormatNumberRequest
+		want string
+	}{
+		{&calculatorpb.FormatNumberRequest{Number: 255, Base: 16}, "ff"},
+		{&calculatorpb.FormatNumberRequest{Number: 255, Base: 16, Uppercase: true}, "FF"},
+		{&calculatorpb.FormatNumberRequest{Number: -10, Base: 2}, "-1010"},
+		{&calculatorpb.FormatNumberRequest{Number: 1994, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN}, "MCMXCIV"},
+		{&calculatorpb.FormatNumberRequest{Number: 1200, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC}, "1.2e3"},
+		{&calculatorpb.FormatNumberRequest{Number: 112, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS}, "one hundred and twelve"},
+		{
+			&calculatorpb.FormatNumberRequest{BigNumber: &calculatorpb.BigNumber{Value: "602214076000000000000000"}, Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC},
+			"6.02214076e23",
+		},
+	}
+	for _, tt := range tests {
+		res, err := (&server{}).FormatNumber(context.Background(), tt.in)
+		if err != nil {
+			t.Errorf("FormatNumber(%v) failed: %v", tt.in, err)
+			continue
+		}
+		if res.GetText() != tt.want {
+			t.Errorf("FormatNumber(%v) = %q, want %q", tt.in, res.GetText(), tt.want)
+		}
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		in   *calculatorpb.ParseNumberRequest
+		want string
+	}{
+		{&calculatorpb.ParseNumberRequest{Text: "FF", Base: 16}, "255"},
+		{&calculatorpb.ParseNumberRequest{Text: " -1010 ", Base: 2}, "-10"},
+		{&calculatorpb.ParseNumberRequest{Text: "mcmxciv", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN}, "1994"},
+		{&calculatorpb.ParseNumberRequest{Text: "1.2E+3", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC}, "1200"},
+		{&calculatorpb.ParseNumberRequest{Text: "One Hundred And Twelve", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS}, "112"},
+	}
+	for _, tt := range tests {
+		res, err := (&server{}).ParseNumber(context.Background(), tt.in)
+		if err != nil {
+			t.Errorf("ParseNumber(%v) failed: %v", tt.in, err)
+			continue
+		}
+		if got := res.GetBigNumber().GetValue(); got != tt.want {
+			t.Errorf("ParseNumber(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseNumberErrors(t *testing.T) {
+	tests := []struct {
+		in   *calculatorpb.ParseNumberRequest
+		want string // a part of the error message
+	}{
+		{&calculatorpb.ParseNumberRequest{Text: ""}, "empty"},
+		{&calculatorpb.ParseNumberRequest{Text: "12g", Base: 16}, "column 3"},
+		{&calculatorpb.ParseNumberRequest{Text: "MCMXCIIII", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN}, "MCMXCIV"},
+		{&calculatorpb.ParseNumberRequest{Text: "1.25e1", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC}, "not an integer"},
+		{&calculatorpb.ParseNumberRequest{Text: "1e19729", Notation: calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC}, "at most 65536 are supported"},
+		{&calculatorpb.ParseNumberRequest{Text: "1" + strings.Repeat("0", maxNumberBits), Base: 2}, "at most 65536 are supported"},
+		{&calculatorpb.ParseNumberRequest{Text: strings.Repeat("1", maxNumberTextLength+1), Base: 2}, "characters"},
+	}
+	for _, tt := range tests {
+		_, err := (&server{}).ParseNumber(context.Background(), tt.in)
+		if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseNumber(%.60v) = %v, want INVALID_ARGUMENT containing %q", tt.in, err, tt.want)
+		}
+	}
+}
+
+// Whatever FormatNumber writes, ParseNumber reads back as the same number
+func TestNumberRoundTrip(t *testing.T) {
+	values := []string{"0", "-1", "3999", "999999999999999999999999999999999999", "1e9999", "-" + strings.Repeat("9", 9990) + "e9000"}
+	notations := []struct {
+		notation calculatorpb.NumberNotation
+		base     uint32
+	}{
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 2},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 10},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 36},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC, 0},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN, 0},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS, 0},
+	}
+	for _, v := range values {
+		want, err := parseBigInteger(&calculatorpb.BigNumber{Value: v})
+		if err != nil {
+			t.Fatalf("parseBigInteger(%.20v) failed: %v", v, err)
+		}
+		for _, n := range notations {
+			formatted, err := (&server{}).FormatNumber(context.Background(), &calculatorpb.FormatNumberRequest{
+				BigNumber: &calculatorpb.BigNumber{Value: v},
+				Notation:  n.notation,
+				Base:      n.base,
+			})
+			if err != nil {
+				// Roman numerals and words only cover some numbers
+				continue
+			}
+			parsed, err := (&server{}).ParseNumber(context.Background(), &calculatorpb.ParseNumberRequest{
+				Text:     formatted.GetText(),
+				Notation: n.notation,
+				Base:     n.base,
+			})
+			if err != nil {
+				t.Errorf("%.20v in %v base %d: ParseNumber of %d characters failed: %v", v, n.notation, n.base, len(formatted.GetText()), err)
+				continue
+			}
+			if got := parsed.GetBigNumber().GetValue(); got != want.String() {
+				t.Errorf("%.20v in %v base %d: read back as %.20v", v, n.notation, n.base, got)
+			}
+		}
+	}
+
+	// a number too large to read back is not written either
+	_, err := (&server{}).FormatNumber(context.Background(), &calculatorpb.FormatNumberRequest{
+		BigNumber: &calculatorpb.BigNumber{Value: strings.Repeat("9", 9990) + "e10000"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("FormatNumber of a 20000 digit number = %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+// Whatever ParseNumber returns, FormatNumber writes back as the same text,
+// up to the largest number both accept
+func TestNumberRoundTripFromText(t *testing.T) {
+	largest := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), maxNumberBits), big.NewInt(1))
+	negative := new(big.Int).Neg(largest)
+	tests := []struct {
+		notation calculatorpb.NumberNotation
+		base     uint32
+		text     string
+	}{
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 2, largest.Text(2)},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 2, negative.Text(2)},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 10, largest.Text(10)},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_POSITIONAL, 36, negative.Text(36)},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC, 0, formatScientific(largest)},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_SCIENTIFIC, 0, "1e19728"},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_ROMAN, 0, "MMMCMXCIX"},
+		{calculatorpb.NumberNotation_NUMBER_NOTATION_WORDS, 0, "minus nine hundred and ninety-nine decillion"},
+	}
+	for _, tt := range tests {
+		parsed, err := (&server{}).ParseNumber(context.Background(), &calculatorpb.ParseNumberRequest{
+			Text:     tt.text,
+			Notation: tt.notation,
+			Base:     tt.base,
+		})
+		if err != nil {
+			t.Errorf("%.20v in %v base %d: ParseNumber failed: %v", tt.text, tt.notation, tt.base, err)
+			continue
+		}
+		formatted, err := (&server{}).FormatNumber(context.Background(), &calculatorpb.FormatNumberRequest{
+			BigNumber: parsed.GetBigNumber(),
+			Notation:  tt.notation,
+			Base:      tt.base,
+		})
+		if err != nil {
+			t.Errorf("%.20v in %v base %d: FormatNumber of %d characters failed: %v", tt.text, tt.notation, tt.base, len(parsed.GetBigNumber().GetValue()), err)
+			continue
+		}
+		if formatted.GetText() != tt.text {
+			t.Errorf("%.20v in %v base %d: written back as %.20v", tt.text, tt.notation, tt.base, formatted.GetText())
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// maxModularBits bounds the moduli and exponents of ModPow and the numbers
+// tested by IsPrime, whose cost grows with the cube of the size
+const maxModularBits = 4096
+
+// parseBigInteger reads a BigNumber that must be an integer
+func parseBigInteger(n *calculatorpb.BigNumber) (*big.Int, error) {
+	r, err := parseBigNumber(n)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%v is not an integer", r.RatString())
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// parseModulus reads a BigNumber that must be a positive integer of at most maxModularBits
+func parseModulus(n *calculatorpb.BigNumber) (*big.Int, error) {
+	m, err := parseBigInteger(n)
+	if err != nil {
+		return nil, err
+	}
+	if m.Sign() <= 0 {
+		return nil, fmt.Errorf("modulus %v is not positive", m)
+	}
+	if m.BitLen() > maxModularBits {
+		return nil, fmt.Errorf("moduli of more than %d bits are not supported", maxModularBits)
+	}
+	return m, nil
+}
+
+func formatBigInteger(n *big.Int) *calculatorpb.BigNumber {
+	return &calculatorpb.BigNumber{Value: n.String()}
+}
+
+// lcm returns the least common multiple of |a| and |b|, 0 when either is 0
+func lcm(a, b *big.Int) *big.Int {
+	if a.Sign() == 0 || b.Sign() == 0 {
+		return new(big.Int)
+	}
+	gcd := new(big.Int).GCD(nil, nil, a, b)
+	res := new(big.Int).Quo(a, gcd)
+	res.Mul(res, b)
+	return res.Abs(res)
+}
+
+// modInverse returns the x in [0, m) with a*x = 1 mod m, or an error when
+// a and m are not coprime
+func modInverse(a, m *big.Int) (*big.Int, error) {
+	if m.Cmp(bigOne) == 0 {
+		return new(big.Int), nil
+	}
+	reduced := new(big.Int).Mod(a, m)
+	res := new(big.Int).ModInverse(reduced, m)
+	if res == nil {
+		gcd := new(big.Int).GCD(nil, nil, reduced, m)
+		return nil, fmt.Errorf("%v has no inverse modulo %v, they share the factor %v", a, m, gcd)
+	}
+	return res, nil
+}
+
+func (*server) Gcd(ctx context.Context, in *calculatorpb.GcdRequest) (*calculatorpb.GcdResponse, error) {
+	fmt.Printf("Received Gcd RPC: %v\n", in)
+
+	first, err := parseBigInteger(in.GetFirstNumber())
+	if err != nil {
+		return nil, invalidArgument("first_number", err)
+	}
+	second, err := parseBigInteger(in.GetSecondNumber())
+	if err != nil {
+		return nil, invalidArgument("second_number", err)
+	}
+
+	return &calculatorpb.GcdResponse{
+		Result: formatBigInteger(new(big.Int).GCD(nil, nil, first, second)),
+	}, nil
+}
+
+func (*server) Lcm(ctx context.Context, in *calculatorpb.LcmRequest) (*calculatorpb.LcmResponse, error) {
+	fmt.Printf("Received Lcm RPC: %v\n", in)
+
+	first, err := parseBigInteger(in.GetFirstNumber())
+	if err != nil {
+		return nil, invalidArgument("first_number", err)
+	}
+	second, err := parseBigInteger(in.GetSecondNumber())
+	if err != nil {
+		return nil, invalidArgument("second_number", err)
+	}
+
+	return &calculatorpb.LcmResponse{
+		Result: formatBigInteger(lcm(first, second)),
+	}, nil
+}
+
+func (*server) ModPow(ctx context.Context, in *calculatorpb.ModPowRequest) (*calculatorpb.ModPowResponse, error) {
+	fmt.Printf("Received ModPow RPC: %v\n", in)
+
+	base, err := parseBigInteger(in.GetBase())
+	if err != nil {
+		return nil, invalidArgument("base", err)
+	}
+	exponent, err := parseBigInteger(in.GetExponent())
+	if err != nil {
+		return nil, invalidArgument("exponent", err)
+	}
+	if exponent.BitLen() > maxModularBits {
+		return nil, invalidArgument("exponent", fmt.Errorf("exponents of more than %d bits are not supported", maxModularBits))
+	}
+	modulus, err := parseModulus(in.GetModulus())
+	if err != nil {
+		return nil, invalidArgument("modulus", err)
+	}
+
+	base.Mod(base, modulus)
+	if exponent.Sign() < 0 {
+		// a^-e is (a^-1)^e
+		inverse, err := modInverse(base, modulus)
+		if err != nil {
+			return nil, invalidArgument("base", fmt.Errorf("a negative exponent needs the inverse of the base: %v", err))
+		}
+		base = inverse
+		exponent.Neg(exponent)
+	}
+
+	return &calculatorpb.ModPowResponse{
+		Result: formatBigInteger(new(big.Int).Exp(base, exponent, modulus)),
+	}, nil
+}
+
+func (*server) ModInverse(ctx context.Context, in *calculatorpb.ModInverseRequest) (*calculatorpb.ModInverseResponse, error) {
+	fmt.Printf("Received ModInverse RPC: %v\n", in)
+
+	number, err := parseBigInteger(in.GetNumber())
+	if err != nil {
+		return nil, invalidArgument("number", err)
+	}
+	modulus, err := parseModulus(in.GetModulus())
+	if err != nil {
+		return nil, invalidArgument("modulus", err)
+	}
+
+	inverse, err := modInverse(number, modulus)
+	if err != nil {
+		return nil, invalidArgument("number", err)
+	}
+
+	return &calculatorpb.ModInverseResponse{
+		Result: formatBigInteger(inverse),
+	}, nil
+}
+
+func (*server) IsPrime(ctx context.Context, in *calculatorpb.IsPrimeRequest) (*calculatorpb.IsPrimeResponse, error) {
+	fmt.Printf("Received IsPrime RPC: %v\n", in)
+
+	number, err := parseBigInteger(in.GetNumber())
+	if err != nil {
+		return nil, invalidArgument("number", err)
+	}
+	if number.BitLen() > maxModularBits {
+		return nil, invalidArgument("number", fmt.Errorf("numbers of more than %d bits are not supported", maxModularBits))
+	}
+
+	// ProbablyPrime is exact below 2^64 and never rejects a prime, so only
+	// the primes above 2^64 rest on the tests having no known counterexample
+	isPrime := number.Sign() > 0 && number.ProbablyPrime(millerRabinRounds)
+	return &calculatorpb.IsPrimeResponse{
+		IsPrime: isPrime,
+		Proven:  !isPrime || number.BitLen() <= 64,
+	}, nil
+}
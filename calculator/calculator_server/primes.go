@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+const (
+	// maxGeneratePrimesTo bounds the end of a range, so the primes up to its
+	// square root that sieve every segment stay below a million
+	maxGeneratePrimesTo = 1e12
+
+	// sieveSegmentSize is how many numbers are sieved at a time, which bounds
+	// the memory of a request whatever the size of its range
+	sieveSegmentSize = 1 << 18
+
+	// maxPrimesPerMessage bounds the primes sent in a single response
+	maxPrimesPerMessage = 4096
+)
+
+// sievePrimes returns the primes up to n with a plain sieve of Eratosthenes
+func sievePrimes(n uint64) []uint64 {
+	composite := make([]bool, n+1)
+	primes := []uint64{}
+	for i := uint64(2); i <= n; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for m := i * i; m <= n; m += i {
+			composite[m] = true
+		}
+	}
+	return primes
+}
+
+// generatePrimes calls emit with the primes in [from, to) in increasing order,
+// one segment at a time, and stops after limit primes unless limit is 0.
+// It returns ctx.Err() when ctx is done before the range is sieved.
+func generatePrimes(ctx context.Context, from, to, limit uint64, emit func(primes []uint64) error) error {
+	if from < 2 {
+		from = 2
+	}
+	if to <= from {
+		return nil
+	}
+
+	root := uint64(math.Sqrt(float64(to - 1)))
+	for (root+1)*(root+1) <= to-1 {
+		root++
+	}
+	base := sievePrimes(root)
+	composite := make([]bool, sieveSegmentSize)
+	found := []uint64{}
+	emitted := uint64(0)
+
+	for lo := from; lo < to; lo += sieveSegmentSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		hi := lo + sieveSegmentSize
+		if hi > to {
+			hi = to
+		}
+
+		segment := composite[:hi-lo]
+		for i := range segment {
+			segment[i] = false
+		}
+		for _, p := range base {
+			if p*p >= hi {
+				break
+			}
+			// the first multiple of p in the segment that a smaller prime did not cross out
+			start := (lo + p - 1) / p * p
+			if start < p*p {
+				start = p * p
+			}
+			for m := start; m < hi; m += p {
+				segment[m-lo] = true
+			}
+		}
+
+		found = found[:0]
+		for i, isComposite := range segment {
+			if isComposite {
+				continue
+			}
+			found = append(found, lo+uint64(i))
+			if limit > 0 && emitted+uint64(len(found)) == limit {
+				break
+			}
+		}
+		for i := 0; i < len(found); i += maxPrimesPerMessage {
+			j := i + maxPrimesPerMessage
+			if j > len(found) {
+				j = len(found)
+			}
+			if err := emit(found[i:j]); err != nil {
+				return err
+			}
+		}
+		emitted += uint64(len(found))
+		if limit > 0 && emitted == limit {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (*server) GeneratePrimes(in *calculatorpb.GeneratePrimesRequest, stream calculatorpb.CalculatorService_GeneratePrimesServer) error {
+	fmt.Printf("Received GeneratePrimes RPC: %v\n", in)
+
+	if in.GetTo() < in.GetFrom() {
+		return invalidArgument("to", fmt.Errorf("the range [%d, %d) is reversed", in.GetFrom(), in.GetTo()))
+	}
+	if in.GetTo() > maxGeneratePrimesTo {
+		return invalidArgument("to", fmt.Errorf("ranges must end at or below %d", uint64(maxGeneratePrimesTo)))
+	}
+
+	// the stream context is cancelled when the client goes away
+	ctx := stream.Context()
+	err := generatePrimes(ctx, in.GetFrom(), in.GetTo(), in.GetLimit(), func(primes []uint64) error {
+		return stream.Send(&calculatorpb.GeneratePrimesResponse{
+			Primes: primes,
+		})
+	})
+	if err == context.Canceled {
+		fmt.Println("The client cancelled the prime generation!")
+		return status.Error(codes.Canceled, "the client cancelled the request")
+	}
+	if err == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, "the prime generation did not finish before the deadline")
+	}
+	return err
+}
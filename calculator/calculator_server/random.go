@@ -0,0 +1,266 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// Limits of a RandomNumbers request
+const (
+	defaultRandomBatch = 1024
+	maxRandomBatch     = 4096
+	maxRandomMagnitude = 1 << 53 // parameters and integers stay exact as float64
+	maxPoissonMean     = 1e9
+	poissonPTRSMean    = 10 // from this mean on, Poisson numbers use rejection sampling
+)
+
+// cryptoSource is a rand.Source64 reading the operating system's
+// cryptographically secure generator. A read error is kept and stops the stream.
+type cryptoSource struct {
+	r   *bufio.Reader
+	err error
+}
+
+func newCryptoSource() *cryptoSource {
+	return &cryptoSource{r: bufio.NewReaderSize(crand.Reader, 4096)}
+}
+
+func (s *cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := io.ReadFull(s.r, b[:]); err != nil && s.err == nil {
+		s.err = err
+	}
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+func (s *cryptoSource) Int63() int64 { return int64(s.Uint64() >> 1) }
+
+// Seed does nothing, a cryptographic source cannot be replayed
+func (s *cryptoSource) Seed(int64) {}
+
+// randomSeed draws a seed for a request that did not give one
+func randomSeed() (int64, error) {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return 0, err
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]) >> 1), nil
+}
+
+// poisson draws a Poisson number of the given mean. Small means multiply
+// uniforms until they drop below e^-mean (Knuth); larger ones use Hörmann's
+// transformed rejection with squeeze (PTRS), which takes constant time.
+func poisson(r *rand.Rand, mean float64) float64 {
+	if mean < poissonPTRSMean {
+		limit := math.Exp(-mean)
+		k := 0.0
+		for p := r.Float64(); p > limit; p *= r.Float64() {
+			k++
+		}
+		return k
+	}
+
+	slam := math.Sqrt(mean)
+	logMean := math.Log(mean)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invAlpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+	for {
+		u := r.Float64() - 0.5
+		v := r.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + mean + 0.43)
+		if us >= 0.07 && v <= vr {
+			return k
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invAlpha)-math.Log(a/(us*us)+b) <= -mean+k*logMean-lg {
+			return k
+		}
+	}
+}
+
+// randomGenerator draws numbers of one distribution
+type randomGenerator struct {
+	r            *rand.Rand
+	distribution calculatorpb.RandomDistribution
+	integers     bool
+	min, max     float64
+	mean, stdDev float64
+}
+
+func checkRandomParameter(name string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) || math.Abs(x) > maxRandomMagnitude {
+		return fmt.Errorf("%v %v is not a number in [-2^53, 2^53]", name, x)
+	}
+	return nil
+}
+
+// newRandomGenerator validates the distribution of a request and fills in its defaults
+func newRandomGenerator(in *calculatorpb.RandomNumbersRequest, r *rand.Rand) (*randomGenerator, string, error) {
+	g := &randomGenerator{
+		r:            r,
+		distribution: in.GetDistribution(),
+		integers:     in.GetIntegers(),
+		min:          in.GetMin(),
+		max:          in.GetMax(),
+		mean:         in.GetMean(),
+		stdDev:       in.GetStandardDeviation(),
+	}
+	for _, p := range []struct {
+		field string
+		x     float64
+	}{{"min", g.min}, {"max", g.max}, {"mean", g.mean}, {"standard_deviation", g.stdDev}} {
+		if err := checkRandomParameter(p.field, p.x); err != nil {
+			return nil, p.field, err
+		}
+	}
+
+	switch g.distribution {
+	case calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_UNSPECIFIED, calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_UNIFORM:
+		if g.min == 0 && g.max == 0 {
+			g.max = 1
+		}
+		if g.integers && (g.min != math.Trunc(g.min) || g.max != math.Trunc(g.max)) {
+			return nil, "min", fmt.Errorf("the range [%v, %v] of integers must have integer bounds", g.min, g.max)
+		}
+		if g.min > g.max || (g.min == g.max && !g.integers) {
+			return nil, "max", fmt.Errorf("the range [%v, %v) is empty", g.min, g.max)
+		}
+	case calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_NORMAL:
+		if g.stdDev == 0 {
+			g.stdDev = 1
+		}
+		if g.stdDev < 0 {
+			return nil, "standard_deviation", fmt.Errorf("standard deviation %v is negative", g.stdDev)
+		}
+	case calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_EXPONENTIAL, calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_POISSON:
+		if g.mean == 0 {
+			g.mean = 1
+		}
+		if g.mean < 0 {
+			return nil, "mean", fmt.Errorf("mean %v is negative", g.mean)
+		}
+		if g.distribution == calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_POISSON {
+			if g.mean > maxPoissonMean {
+				return nil, "mean", fmt.Errorf("mean %v of Poisson numbers is above %v", g.mean, maxPoissonMean)
+			}
+			g.integers = true
+		}
+	default:
+		return nil, "distribution", fmt.Errorf("unsupported distribution %v", g.distribution)
+	}
+	return g, "", nil
+}
+
+func (g *randomGenerator) next() float64 {
+	switch g.distribution {
+	case calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_NORMAL:
+		return g.mean + g.stdDev*g.r.NormFloat64()
+	case calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_EXPONENTIAL:
+		return g.mean * g.r.ExpFloat64()
+	case calculatorpb.RandomDistribution_RANDOM_DISTRIBUTION_POISSON:
+		return poisson(g.r, g.mean)
+	}
+	if g.integers {
+		// the bounds are at most 2^53 apart, so the span fits Int63n
+		return g.min + float64(g.r.Int63n(int64(g.max-g.min)+1))
+	}
+	return g.min + (g.max-g.min)*g.r.Float64()
+}
+
+// nextInteger rounds the next number, keeping it within int64
+func (g *randomGenerator) nextInteger() int64 {
+	x := math.Round(g.next())
+	return int64(math.Max(math.MinInt64, math.Min(x, math.MaxInt64)))
+}
+
+func (*server) RandomNumbers(in *calculatorpb.RandomNumbersRequest, stream calculatorpb.CalculatorService_RandomNumbersServer) error {
+	fmt.Printf("Received RandomNumbers RPC: %v\n", in)
+
+	batch := int(in.GetBatchSize())
+	if batch == 0 {
+		batch = defaultRandomBatch
+	}
+	if batch > maxRandomBatch {
+		return invalidArgument("batch_size", fmt.Errorf("batches hold at most %d numbers", maxRandomBatch))
+	}
+
+	var src rand.Source
+	var crypto *cryptoSource
+	seed := in.GetSeed()
+	switch {
+	case in.GetCrypto():
+		crypto = newCryptoSource()
+		src = crypto
+	case in.GetSource() == nil:
+		var err error
+		if seed, err = randomSeed(); err != nil {
+			return status.Errorf(codes.Internal, fmt.Sprintf("cannot draw a seed: %v", err))
+		}
+		fallthrough
+	default:
+		src = rand.NewSource(seed)
+	}
+	g, field, err := newRandomGenerator(in, rand.New(src))
+	if err != nil {
+		return invalidArgument(field, err)
+	}
+
+	// Send blocks while the client's flow control window is full, so numbers
+	// are only generated as fast as the client reads them
+	ctx := stream.Context()
+	for sent, count := uint64(0), in.GetCount(); count == 0 || sent < count; {
+		if err := ctx.Err(); err != nil {
+			if errors.Is(err, context.Canceled) {
+				fmt.Println("The client cancelled the random numbers!")
+			}
+			return status.FromContextError(err).Err()
+		}
+
+		n := uint64(batch)
+		if count > 0 && count-sent < n {
+			n = count - sent
+		}
+		res := &calculatorpb.RandomNumbersResponse{}
+		if sent == 0 && crypto == nil {
+			res.Seed = seed
+		}
+		if g.integers {
+			res.Integers = make([]int64, n)
+			for i := range res.Integers {
+				res.Integers[i] = g.nextInteger()
+			}
+		} else {
+			res.Numbers = make([]float64, n)
+			for i := range res.Numbers {
+				res.Numbers[i] = g.next()
+			}
+		}
+		if crypto != nil && crypto.err != nil {
+			return status.Errorf(codes.Internal, fmt.Sprintf("the secure random source failed: %v", crypto.err))
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+		sent += n
+	}
+	return nil
+}
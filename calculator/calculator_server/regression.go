@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// runningRegression accumulates the means and co-moments of a stream of points
+// with Welford's online algorithm, so a fit needs no buffering and keeps its
+// precision on long streams.
+type runningRegression struct {
+	count        uint64
+	meanX, meanY float64
+	sxx, syy     float64 // sums of squared differences from the means
+	sxy          float64 // sum of products of the differences from the means
+}
+
+func (r *runningRegression) add(x, y float64) {
+	r.count++
+	n := float64(r.count)
+	dx := x - r.meanX
+	dy := y - r.meanY
+	r.meanX += dx / n
+	r.meanY += dy / n
+	r.sxx += dx * (x - r.meanX)
+	r.syy += dy * (y - r.meanY)
+	r.sxy += dx * (y - r.meanY)
+}
+
+func (r *runningRegression) fit() *calculatorpb.FitLinearRegressionResponse {
+	n := float64(r.count)
+	slope := r.sxy / r.sxx
+	res := &calculatorpb.FitLinearRegressionResponse{
+		Count:     r.count,
+		Slope:     slope,
+		Intercept: r.meanY - slope*r.meanX,
+	}
+	if r.syy > 0 {
+		res.Correlation = r.sxy / math.Sqrt(r.sxx*r.syy)
+		// rounding can push |r| a hair above 1
+		res.Correlation = math.Max(-1, math.Min(1, res.Correlation))
+		res.RSquared = res.Correlation * res.Correlation
+	}
+	if r.count > 2 {
+		residuals := math.Max(0, r.syy-slope*r.sxy)
+		variance := residuals / (n - 2)
+		res.SlopeStandardError = math.Sqrt(variance / r.sxx)
+		res.InterceptStandardError = math.Sqrt(variance * (1/n + r.meanX*r.meanX/r.sxx))
+	}
+	return res
+}
+
+func (*server) FitLinearRegression(stream calculatorpb.CalculatorService_FitLinearRegressionServer) error {
+	fmt.Println("Received FitLinearRegression RPC")
+
+	reg := &runningRegression{}
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		x, y := req.GetX(), req.GetY()
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return invalidArgument("x", fmt.Errorf("x of point %d is %v", reg.count+1, x))
+		}
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			return invalidArgument("y", fmt.Errorf("y of point %d is %v", reg.count+1, y))
+		}
+		reg.add(x, y)
+	}
+
+	if reg.count < 2 {
+		return invalidArgument("x", fmt.Errorf("received %d points, a line needs at least 2", reg.count))
+	}
+	if reg.sxx == 0 {
+		return invalidArgument("x", errors.New("every point has the same x, the slope is undefined"))
+	}
+
+	return stream.SendAndClose(reg.fit())
+}
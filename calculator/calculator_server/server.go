@@ -2,17 +2,22 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"math"
+	"math/big"
+	"math/cmplx"
 	"net"
 	// "time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
 )
@@ -25,10 +30,17 @@ func (*server) Sum(ctx context.Context, req *calculatorpb.SumRequest) (*calculat
 	firstNumber := req.FirstNumber
 	secondNumber := req.SecondNumber
 
-	sum := firstNumber + secondNumber
+	// add in 64 bits, where two int32 cannot overflow, and check the result fits
+	sum := int64(firstNumber) + int64(secondNumber)
+	if sum < math.MinInt32 || sum > math.MaxInt32 {
+		r, _ := integerTypeRange(calculatorpb.IntegerType_INTEGER_TYPE_INT32)
+		expression := fmt.Sprintf("%d + %d", firstNumber, secondNumber)
+		res := big.NewInt(sum)
+		return nil, integerOverflowError(expression, res, res.Sign(), r)
+	}
 
 	res := &calculatorpb.SumResponse{
-		SumResult: sum,
+		SumResult: int32(sum),
 	}
 
 	return res, nil
@@ -38,36 +50,101 @@ func (*server) PrimeNumberDecomposition(in *calculatorpb.PrimeNumberDecompositio
 
 	fmt.Printf("PrimeNumberDecomposition function was invoked with %v\n", in)
 
-	number := in.GetNumber()
-
-	primeDecomposition := getPrimeNumberDecomposition(number)
+	number, err := decompositionNumber(in)
+	if err != nil {
+		return err
+	}
 
-	for _, value := range primeDecomposition {
-		res := &calculatorpb.PrimeNumberDecompositionResponse{
-			PrimeNumberDecomposition: value,
+	// the stream context is cancelled when the client goes away
+	ctx := stream.Context()
+	// factors are streamed as they are found when this request computes them,
+	// and all at once when they come from the cache or an identical request
+	streamed := false
+	res, err := results.do(ctx, primeFactorsKey(number), func(ctx context.Context) (proto.Message, error) {
+		streamed = true
+		factors := &calculatorpb.PrimeFactors{}
+		err := factorize(ctx, number, func(prime *big.Int, multiplicity uint32) error {
+			factor := primeFactor(prime, multiplicity)
+			factors.Factors = append(factors.Factors, factor)
+			return sendFactor(stream, factor, in.GetGroupFactors())
+		})
+		return factors, err
+	})
+	if err == nil && !streamed {
+		for _, factor := range res.(*calculatorpb.PrimeFactors).GetFactors() {
+			if err := sendFactor(stream, factor, in.GetGroupFactors()); err != nil {
+				return err
+			}
 		}
-
-		stream.Send(res)
-		// time.Sleep(time.Second * 1)
 	}
-
-	return nil
+	if err == context.Canceled {
+		fmt.Println("The client cancelled the decomposition!")
+		return status.Error(codes.Canceled, "the client cancelled the request")
+	}
+	if err == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, "the decomposition did not finish before the deadline")
+	}
+	return err
 }
 
-func getPrimeNumberDecomposition(number int32) (primeDecomposition []int32) {
+// decompositionNumber reads the number to decompose, from number or big_number
+func decompositionNumber(in *calculatorpb.PrimeNumberDecompositionRequest) (*big.Int, error) {
+	number, field := big.NewInt(in.GetNumber()), "number"
+	if in.GetBigNumber() != nil {
+		field = "big_number"
+		r, err := parseBigNumber(in.GetBigNumber())
+		if err != nil {
+			return nil, invalidArgument(field, err)
+		}
+		if !r.IsInt() {
+			return nil, invalidArgument(field, fmt.Errorf("%v is not an integer", r.RatString()))
+		}
+		number = r.Num()
+	}
+	if number.Cmp(bigTwo) < 0 {
+		return nil, invalidArgument(field, fmt.Errorf("%v has no prime decomposition, the number must be at least 2", number))
+	}
+	if number.BitLen() > maxFactorizeBits {
+		return nil, invalidArgument(field, fmt.Errorf("numbers of more than %d bits are not supported", maxFactorizeBits))
+	}
+	return number, nil
+}
 
-	k := int32(2)
-	res := []int32{}
+// factorMessages returns how factor is sent: as is when the client groups
+// factors, otherwise once for every time the prime divides the number, with
+// multiplicity 1, as PrimeNumberDecomposition always did
+func factorMessages(factor *calculatorpb.PrimeNumberDecompositionResponse, grouped bool) []*calculatorpb.PrimeNumberDecompositionResponse {
+	if grouped {
+		return []*calculatorpb.PrimeNumberDecompositionResponse{factor}
+	}
+	res := make([]*calculatorpb.PrimeNumberDecompositionResponse, factor.GetMultiplicity())
+	for i := range res {
+		res[i] = &calculatorpb.PrimeNumberDecompositionResponse{
+			PrimeNumberDecomposition: factor.GetPrimeNumberDecomposition(),
+			Prime:                    factor.GetPrime(),
+			Multiplicity:             1,
+		}
+	}
+	return res
+}
 
-	for number > 1 {
-		if number%k == 0 {
-			res = append(res, k)
-			number = number / k
-		} else {
-			k++
+func sendFactor(stream calculatorpb.CalculatorService_PrimeNumberDecompositionServer, factor *calculatorpb.PrimeNumberDecompositionResponse, grouped bool) error {
+	for _, msg := range factorMessages(factor, grouped) {
+		if err := stream.Send(msg); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
+func primeFactor(prime *big.Int, multiplicity uint32) *calculatorpb.PrimeNumberDecompositionResponse {
+	res := &calculatorpb.PrimeNumberDecompositionResponse{
+		Prime:        &calculatorpb.BigNumber{Value: prime.String()},
+		Multiplicity: multiplicity,
+	}
+	if prime.IsInt64() {
+		res.PrimeNumberDecomposition = prime.Int64()
+	}
 	return res
 }
 
@@ -131,6 +208,22 @@ func (*server) SquareRoot(ctx context.Context, in *calculatorpb.SquareRootReques
 	fmt.Println("Received SquareRoot RPC")
 
 	number := in.GetNumber()
+	domain := in.GetNumberDomain()
+	if err := validNumberDomain(domain); err != nil {
+		return nil, invalidArgument("number_domain", err)
+	}
+
+	if domain == calculatorpb.NumberDomain_NUMBER_DOMAIN_COMPLEX {
+		root := cmplx.Sqrt(complex(float64(number), 0))
+		res := &calculatorpb.SquareRootResponse{
+			ComplexRoot: complexNumber(root),
+			Text:        formatComplex(root),
+		}
+		if number >= 0 {
+			res.NumberRoot = real(root)
+		}
+		return res, nil
+	}
 
 	if number < 0 {
 		//  send back an error
@@ -140,12 +233,69 @@ func (*server) SquareRoot(ctx context.Context, in *calculatorpb.SquareRootReques
 		)
 	}
 
+	if domain == calculatorpb.NumberDomain_NUMBER_DOMAIN_INTEGER || domain == calculatorpb.NumberDomain_NUMBER_DOMAIN_RATIONAL {
+		root := new(big.Int).Sqrt(big.NewInt(int64(number)))
+		if root.Int64()*root.Int64() != int64(number) {
+			return nil, invalidArgument("number", fmt.Errorf("%v is not a perfect square, its root is irrational; use the real domain", number))
+		}
+		return &calculatorpb.SquareRootResponse{
+			NumberRoot: float64(root.Int64()),
+			ExactRoot:  formatBigInteger(root),
+			Text:       root.String(),
+		}, nil
+	}
+
+	root := math.Sqrt(float64(number))
 	return &calculatorpb.SquareRootResponse{
-		NumberRoot: math.Sqrt(float64(number)),
+		NumberRoot: root,
+		Text:       formatReal(root),
 	}, nil
 }
 
+func (*server) Evaluate(ctx context.Context, in *calculatorpb.EvaluateRequest) (*calculatorpb.EvaluateResponse, error) {
+	fmt.Printf("Received Evaluate RPC: %v\n", in)
+
+	if err := validNumberDomain(in.GetNumberDomain()); err != nil {
+		return nil, invalidArgument("number_domain", err)
+	}
+	res, err := evaluateInDomain(in.GetExpression(), in.GetNumberDomain())
+	if err != nil {
+		return nil, invalidArgument("expression", err)
+	}
+
+	return res, nil
+}
+
+// invalidArgument builds an INVALID_ARGUMENT error for a request field,
+// with a BadRequest detail so clients can tell which field was wrong
+func invalidArgument(field string, err error) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid %v: %v", field, err))
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: err.Error()},
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 func main() {
+	unitsPath := flag.String("units", "", "JSON file with the unit table of Convert and EvaluateQuantity, the built-in units.json by default")
+	cacheBytes := flag.Int64("cache-bytes", 64<<20, "size of the cache of results shared by the RPCs, 0 to disable it")
+	flag.Parse()
+
+	if *cacheBytes > 0 {
+		results = newResultCache(*cacheBytes)
+	}
+
+	table, err := loadUnitTable(*unitsPath)
+	if err != nil {
+		log.Fatalf("Failed to load units: %v", err)
+	}
+	units = table
+
 	fmt.Println("Server is running...")
 
 	lis, err := net.Listen("tcp", "0.0.0.0:50051") // port binding
@@ -154,8 +304,9 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()                                      // grpc server
-	calculatorpb.RegisterCalculatorServiceServer(s, &server{}) // register greet service
+	s := grpc.NewServer(grpc.UnaryInterceptor(cacheInterceptor)) // grpc server
+	calculatorpb.RegisterCalculatorServiceServer(s, &server{})   // register greet service
+	calculatorpb.RegisterCalculatorAdminServiceServer(s, &adminServer{})
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
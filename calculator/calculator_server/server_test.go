@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// factorStream collects what PrimeNumberDecomposition sends
+type factorStream struct {
+	grpc.ServerStream
+	sent []string
+}
+
+func (s *factorStream) Context() context.Context { return context.Background() }
+
+func (s *factorStream) Send(res *calculatorpb.PrimeNumberDecompositionResponse) error {
+	factor := res.GetPrime().GetValue()
+	if res.GetMultiplicity() != 1 {
+		factor = fmt.Sprintf("%v^%d", factor, res.GetMultiplicity())
+	}
+	s.sent = append(s.sent, factor)
+	return nil
+}
+
+func TestPrimeNumberDecomposition(t *testing.T) {
+	tests := []struct {
+		in   *calculatorpb.PrimeNumberDecompositionRequest
+		want []string
+	}{
+		{&calculatorpb.PrimeNumberDecompositionRequest{Number: 12}, []string{"2", "2", "3"}},
+		{&calculatorpb.PrimeNumberDecompositionRequest{Number: 12, GroupFactors: true}, []string{"2^2", "3"}},
+		{&calculatorpb.PrimeNumberDecompositionRequest{Number: 97}, []string{"97"}},
+		{&calculatorpb.PrimeNumberDecompositionRequest{BigNumber: &calculatorpb.BigNumber{Value: "1000000016000000063"}}, []string{"1000000009", "1000000007"}}, // not necessarily in increasing order
+	}
+	saved := results
+	results = newResultCache(1 << 20)
+	defer func() { results = saved }()
+
+	for _, tt := range tests {
+		// twice, to get the factors both as computed and from the cache
+		for i := 0; i < 2; i++ {
+			stream := &factorStream{}
+			if err := (&server{}).PrimeNumberDecomposition(tt.in, stream); err != nil {
+				t.Fatalf("PrimeNumberDecomposition(%v) failed: %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(stream.sent, tt.want) {
+				t.Errorf("PrimeNumberDecomposition(%v) sent %v, want %v", tt.in, stream.sent, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// Limits of a session, so a single stream cannot exhaust the server
+const (
+	maxStatementLength = 4096    // characters in a statement
+	maxSessionBytes    = 1 << 20 // estimated memory of the variables and functions of a session
+
+	sessionStatementTimeout = time.Second      // evaluation time of a statement
+	maxSessionEvalTime      = 10 * time.Second // evaluation time of all the statements of a session
+
+	// sessionEntryBytes is the estimated memory of a variable besides its name.
+	// A function is estimated at sessionEntryBytes per character of its
+	// definition, which covers the nodes of its syntax tree.
+	sessionEntryBytes = 64
+)
+
+// Statements extend expressions with assignments and function definitions:
+//
+//	statement  = definition | assignment | expr
+//	definition = "def" ident "(" [ ident { "," ident } ] ")" "=" expr
+//	assignment = ident "=" expr
+type statement struct {
+	name   string   // the variable assigned or the function defined, empty for an expression
+	col    int      // column of name
+	isDef  bool     // whether this is a function definition
+	params []string // parameters of a function definition
+	expr   exprNode
+}
+
+func parseStatement(src string) (*statement, error) {
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := &statement{}
+	first := p.peek()
+	switch {
+	case first.kind == tokIdent && first.text == "def":
+		p.advance()
+		if err := p.definitionHead(stmt); err != nil {
+			return nil, err
+		}
+	case first.kind == tokIdent && p.tokens[1].kind == tokOp && p.tokens[1].text == "=":
+		p.advance()
+		p.advance()
+		stmt.name, stmt.col = first.text, first.col
+		if err := checkSessionName(first); err != nil {
+			return nil, err
+		}
+	}
+
+	stmt.expr, err = p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// definitionHead parses the part of a function definition after "def" up to "="
+func (p *parser) definitionHead(stmt *statement) error {
+	name := p.advance()
+	if name.kind != tokIdent {
+		return errorAt(name.col, "expected a function name, found %v", name)
+	}
+	if err := checkSessionName(name); err != nil {
+		return err
+	}
+	if err := p.expectOp("("); err != nil {
+		return err
+	}
+
+	params := []string{}
+	if !p.isOp(")") {
+		for {
+			param := p.advance()
+			if param.kind != tokIdent {
+				return errorAt(param.col, "expected a parameter name, found %v", param)
+			}
+			for _, prev := range params {
+				if prev == param.text {
+					return errorAt(param.col, "duplicate parameter %v", param.text)
+				}
+			}
+			params = append(params, param.text)
+			if !p.isOp(",") {
+				break
+			}
+			p.advance()
+		}
+	}
+	if err := p.expectOp(")"); err != nil {
+		return err
+	}
+	if err := p.expectOp("="); err != nil {
+		return err
+	}
+
+	stmt.name, stmt.col = name.text, name.col
+	stmt.isDef = true
+	stmt.params = params
+	return nil
+}
+
+// checkSessionName rejects names of variables and functions that would hide a builtin
+func checkSessionName(t token) error {
+	if _, ok := constants[t.text]; ok {
+		return errorAt(t.col, "%v is a constant and cannot be redefined", t.text)
+	}
+	if _, ok := builtins[t.text]; ok {
+		return errorAt(t.col, "%v is a builtin function and cannot be redefined", t.text)
+	}
+	if t.text == "def" {
+		return errorAt(t.col, "def is reserved for function definitions")
+	}
+	return nil
+}
+
+// session holds the variables and functions defined on a Session stream
+type session struct {
+	vars     map[string]float64
+	funcs    map[string]userFunc
+	sizes    map[string]int // estimated memory of each variable and function
+	bytes    int
+	evalTime time.Duration
+}
+
+func newSession() *session {
+	return &session{
+		vars:  map[string]float64{},
+		funcs: map[string]userFunc{},
+		sizes: map[string]int{},
+	}
+}
+
+// run executes a statement. Errors in the statement are part of the response;
+// the returned error ends the stream.
+func (s *session) run(ctx context.Context, src string) (*calculatorpb.SessionResponse, error) {
+	// the evaluator only notices its deadline every evalCheckEvery nodes,
+	// which short statements never reach
+	if s.evalTime >= maxSessionEvalTime {
+		return nil, evalTimeExhausted()
+	}
+	if n := len([]rune(src)); n > maxStatementLength {
+		return sessionError(fmt.Errorf("statement has %d characters, at most %d are supported", n, maxStatementLength)), nil
+	}
+	stmt, err := parseStatement(src)
+	if err != nil {
+		return sessionError(err), nil
+	}
+
+	if stmt.isDef {
+		if _, ok := s.vars[stmt.name]; ok {
+			return sessionError(errorAt(stmt.col, "%v is already a variable", stmt.name)), nil
+		}
+		if err := s.reserve(stmt.name, len(stmt.name)+sessionEntryBytes*len(src)); err != nil {
+			return sessionError(err), nil
+		}
+		s.funcs[stmt.name] = userFunc{params: stmt.params, body: stmt.expr}
+		return &calculatorpb.SessionResponse{
+			Outcome: &calculatorpb.SessionResponse_Function{Function: stmt.name},
+		}, nil
+	}
+
+	if _, ok := s.funcs[stmt.name]; ok {
+		return sessionError(errorAt(stmt.col, "%v is already a function", stmt.name)), nil
+	}
+
+	result, err := s.evaluate(ctx, stmt.expr)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if s.evalTime >= maxSessionEvalTime {
+			return nil, evalTimeExhausted()
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("statement took longer than %v to evaluate", sessionStatementTimeout)
+		}
+		return sessionError(err), nil
+	}
+
+	res := &calculatorpb.SessionResponse{
+		Outcome: &calculatorpb.SessionResponse_Result{Result: result},
+	}
+	if stmt.name != "" {
+		if err := s.reserve(stmt.name, len(stmt.name)+sessionEntryBytes); err != nil {
+			return sessionError(err), nil
+		}
+		s.vars[stmt.name] = result
+		res.Variable = stmt.name
+	}
+	return res, nil
+}
+
+// evaluate evaluates node within the time left to the statement and the session
+func (s *session) evaluate(ctx context.Context, node exprNode) (float64, error) {
+	timeout := sessionStatementTimeout
+	if left := maxSessionEvalTime - s.evalTime; left < timeout {
+		timeout = left
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	result, err := (&evaluator{vars: s.vars, funcs: s.funcs, ctx: ctx}).eval(node)
+	s.evalTime += time.Since(start)
+	return result, err
+}
+
+// reserve accounts for size bytes stored under name, replacing what it held before
+func (s *session) reserve(name string, size int) error {
+	bytes := s.bytes - s.sizes[name] + size
+	if bytes > maxSessionBytes {
+		return fmt.Errorf("session memory is full, at most %d bytes of variables and functions are supported", maxSessionBytes)
+	}
+	s.bytes = bytes
+	s.sizes[name] = size
+	return nil
+}
+
+// evalTimeExhausted ends a session that used up its evaluation time
+func evalTimeExhausted() error {
+	return status.Errorf(codes.ResourceExhausted, fmt.Sprintf("the session used up its %v of evaluation time", maxSessionEvalTime))
+}
+
+func sessionError(err error) *calculatorpb.SessionResponse {
+	res := &calculatorpb.SessionError{Message: err.Error()}
+	if exprErr, ok := err.(*exprError); ok {
+		res.Column = uint32(exprErr.col)
+		res.Message = exprErr.msg
+	}
+	return &calculatorpb.SessionResponse{
+		Outcome: &calculatorpb.SessionResponse_Error{Error: res},
+	}
+}
+
+func (*server) Session(stream calculatorpb.CalculatorService_SessionServer) error {
+	fmt.Println("Received Session RPC")
+
+	s := newSession()
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		res, err := s.run(stream.Context(), req.GetStatement())
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
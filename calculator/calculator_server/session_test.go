@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSession(t *testing.T) {
+	s := newSession()
+	tests := []struct {
+		src  string
+		want float64
+	}{
+		{"x = 2", 2},
+		{"def sq(a) = a * a", 0},
+		{"sq(x) + 1", 5},
+	}
+	for _, tt := range tests {
+		res, err := s.run(context.Background(), tt.src)
+		if err != nil {
+			t.Fatalf("run(%q) failed: %v", tt.src, err)
+		}
+		if res.GetError() != nil {
+			t.Fatalf("run(%q) = %v", tt.src, res.GetError())
+		}
+		if got := res.GetResult(); got != tt.want {
+			t.Errorf("run(%q) = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestSessionEvalTimeExhausted(t *testing.T) {
+	s := newSession()
+	s.evalTime = maxSessionEvalTime - 1
+	if _, err := s.run(context.Background(), "1 + 1"); err != nil {
+		t.Fatalf("run with time left failed: %v", err)
+	}
+
+	s.evalTime = maxSessionEvalTime
+	for _, src := range []string{"1 + 1", "x = 1", "def f(a) = a"} {
+		_, err := s.run(context.Background(), src)
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("run(%q) after the session used up its time: got %v, want code %v", src, err, codes.ResourceExhausted)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// maxStatisticsValues bounds the numbers kept for the median and percentiles, 32 MB of float64
+const maxStatisticsValues = 1 << 22
+
+var defaultPercentiles = []float64{25, 50, 75, 90, 95, 99}
+
+// runningStats accumulates a stream of numbers.
+// The mean and variance use Welford's online algorithm and the sum uses
+// Neumaier's compensated summation, so neither loses precision on long streams.
+// The values themselves are kept for the order statistics.
+type runningStats struct {
+	count    uint64
+	sum      float64
+	sumComp  float64 // running compensation of sum
+	mean     float64
+	m2       float64 // sum of squared differences from the mean
+	min, max float64
+	values   []float64
+}
+
+func (s *runningStats) add(x float64) {
+	s.count++
+	if s.count == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min = math.Min(s.min, x)
+		s.max = math.Max(s.max, x)
+	}
+
+	t := s.sum + x
+	if math.Abs(s.sum) >= math.Abs(x) {
+		s.sumComp += (s.sum - t) + x
+	} else {
+		s.sumComp += (x - t) + s.sum
+	}
+	s.sum = t
+
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+
+	s.values = append(s.values, x)
+}
+
+// variance is the sample variance, 0 for fewer than two numbers
+func (s *runningStats) variance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.count-1)
+}
+
+// percentile returns the p-th percentile of the sorted values,
+// interpolating linearly between the closest ranks
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo])
+}
+
+func validatePercentiles(ps []float64) error {
+	for _, p := range ps {
+		if math.IsNaN(p) || p < 0 || p > 100 {
+			return fmt.Errorf("percentile %v is not in [0, 100]", p)
+		}
+	}
+	return nil
+}
+
+func (*server) ComputeStatistics(stream calculatorpb.CalculatorService_ComputeStatisticsServer) error {
+	fmt.Println("Received ComputeStatistics RPC")
+
+	stats := &runningStats{}
+	var percentiles []float64
+
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if stats.count == 0 {
+			percentiles = req.GetPercentiles()
+			if len(percentiles) == 0 {
+				percentiles = defaultPercentiles
+			}
+			if err := validatePercentiles(percentiles); err != nil {
+				return invalidArgument("percentiles", err)
+			}
+		}
+
+		number := req.GetNumber()
+		if math.IsNaN(number) || math.IsInf(number, 0) {
+			return invalidArgument("number", fmt.Errorf("number %d is %v", stats.count+1, number))
+		}
+		if stats.count == maxStatisticsValues {
+			return status.Errorf(codes.ResourceExhausted, fmt.Sprintf("at most %d numbers are supported", maxStatisticsValues))
+		}
+		stats.add(number)
+	}
+
+	if stats.count == 0 {
+		return invalidArgument("number", errors.New("no numbers were sent, statistics of an empty stream are undefined"))
+	}
+
+	sorted := stats.values
+	sort.Float64s(sorted)
+	res := &calculatorpb.ComputeStatisticsResponse{
+		Count:             stats.count,
+		Sum:               stats.sum + stats.sumComp,
+		Mean:              stats.mean,
+		Variance:          stats.variance(),
+		StandardDeviation: math.Sqrt(stats.variance()),
+		Min:               stats.min,
+		Max:               stats.max,
+		Median:            percentile(sorted, 50),
+	}
+	for _, p := range percentiles {
+		res.Percentiles = append(res.Percentiles, &calculatorpb.Percentile{
+			Percentile: p,
+			Value:      percentile(sorted, p),
+		})
+	}
+
+	return stream.SendAndClose(res)
+}
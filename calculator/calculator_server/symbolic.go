@@ -0,0 +1,893 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// maxSymbolicNodes bounds the numbers, names and operations of an expression
+// to simplify or differentiate, as derivatives can grow quadratically
+const maxSymbolicNodes = 1000
+
+// Expressions are simplified bottom up into a canonical form:
+//
+//   - numbers are exact rationals, written as decimals when they can be and
+//     as fractions such as 1/3 otherwise
+//   - sums are lists of terms, each a rational coefficient times a product,
+//     and terms with the same product are added up, so x + x is 2*x
+//   - products are lists of factors, each a base raised to a rational power,
+//     and factors with the same base add up their exponents, so x*x is x^2
+//     and x/x is 1
+//
+// Terms and factors keep the order in which they first appear, with the
+// coefficient of a product first and the constant of a sum last. Names other
+// than the builtin functions are symbols, whatever their value.
+
+// simplifier simplifies expression trees, returning ctx.Err() once ctx is done
+type simplifier struct {
+	ctx   context.Context
+	exact exactEvaluator
+	steps int
+}
+
+func (s *simplifier) simplify(n exprNode) (exprNode, error) {
+	s.steps++
+	if s.steps%evalCheckEvery == 0 && s.ctx.Err() != nil {
+		return nil, s.ctx.Err()
+	}
+
+	switch n := n.(type) {
+	case *numberNode:
+		x, err := s.exact.eval(n)
+		if err != nil {
+			return nil, err
+		}
+		return ratNode(n.col, x), nil
+	case *identNode:
+		if _, ok := builtins[n.name]; ok {
+			return nil, errorAt(n.col, "function %v must be called with arguments", n.name)
+		}
+		return n, nil
+	case *unaryNode:
+		x, err := s.simplify(n.x)
+		if err != nil {
+			return nil, err
+		}
+		return s.sum(&unaryNode{col: n.col, op: '-', x: x})
+	case *binaryNode:
+		x, err := s.simplify(n.x)
+		if err != nil {
+			return nil, err
+		}
+		y, err := s.simplify(n.y)
+		if err != nil {
+			return nil, err
+		}
+		m := &binaryNode{col: n.col, op: n.op, x: x, y: y}
+		switch n.op {
+		case '+', '-':
+			return s.sum(m)
+		case '*', '/':
+			return s.product(m)
+		case '^':
+			return s.power(m)
+		case '%':
+			return s.modulo(m)
+		}
+		return nil, errorAt(n.col, "unknown operator %q", n.op)
+	case *callNode:
+		return s.call(n)
+	}
+	return nil, errorAt(n.pos(), "cannot simplify %T", n)
+}
+
+// check rejects numbers too large to be kept exactly or written as a double
+func (s *simplifier) check(col int, op string, x *big.Rat) error {
+	if err := s.exact.check(col, x); err != nil {
+		return err
+	}
+	if f, _ := x.Float64(); math.IsInf(f, 0) {
+		return errorAt(col, "%v overflows", op)
+	}
+	return nil
+}
+
+// sumTerm is a term of a sum, its coefficient times its product
+type sumTerm struct {
+	coeff *big.Rat
+	p     *product
+}
+
+// sum simplifies a sum, difference or negation n whose operands are simplified
+func (s *simplifier) sum(n exprNode) (exprNode, error) {
+	col, op := n.pos(), "-"
+	if b, ok := n.(*binaryNode); ok {
+		op = string(b.op)
+	}
+
+	var terms []*sumTerm
+	byKey := map[string]*sumTerm{}
+	constant := new(big.Rat)
+	var add func(n exprNode, negate bool) error
+	add = func(n exprNode, negate bool) error {
+		switch m := n.(type) {
+		case *unaryNode:
+			return add(m.x, !negate)
+		case *binaryNode:
+			if m.op == '+' || m.op == '-' {
+				if err := add(m.x, negate); err != nil {
+					return err
+				}
+				return add(m.y, negate != (m.op == '-'))
+			}
+		}
+
+		p := newProduct(s, col, op)
+		if err := p.multiply(n, big.NewRat(1, 1)); err != nil {
+			return err
+		}
+		if err := p.finish(); err != nil {
+			return err
+		}
+		coeff := p.coeff
+		if negate {
+			coeff.Neg(coeff)
+		}
+		if len(p.factors) == 0 {
+			constant.Add(constant, coeff)
+			return nil
+		}
+		if t, ok := byKey[p.key()]; ok {
+			t.coeff.Add(t.coeff, coeff)
+			return nil
+		}
+		t := &sumTerm{coeff: coeff, p: p}
+		terms = append(terms, t)
+		byKey[p.key()] = t
+		return nil
+	}
+	if err := add(n, false); err != nil {
+		return nil, err
+	}
+	if err := s.check(col, op, constant); err != nil {
+		return nil, err
+	}
+
+	var res exprNode
+	appendTerm := func(coeff *big.Rat, build func(coeff *big.Rat) exprNode) {
+		switch {
+		case coeff.Sign() == 0:
+		case res == nil:
+			res = build(coeff)
+		case coeff.Sign() < 0:
+			res = &binaryNode{col: col, op: '-', x: res, y: build(new(big.Rat).Neg(coeff))}
+		default:
+			res = &binaryNode{col: col, op: '+', x: res, y: build(coeff)}
+		}
+	}
+	// the constant comes last, unless the sum would start with a minus: 1 - x
+	constantFirst := constant.Sign() > 0
+	for _, t := range terms {
+		if err := s.check(col, op, t.coeff); err != nil {
+			return nil, err
+		}
+		if t.coeff.Sign() == 0 {
+			continue
+		}
+		if constantFirst && t.coeff.Sign() < 0 {
+			res = ratNode(col, constant)
+			constant = new(big.Rat)
+		}
+		constantFirst = false
+		appendTerm(t.coeff, func(coeff *big.Rat) exprNode {
+			t.p.coeff = coeff
+			return t.p.build()
+		})
+	}
+	appendTerm(constant, func(coeff *big.Rat) exprNode { return ratNode(col, coeff) })
+	if res == nil {
+		return ratNode(col, constant), nil
+	}
+	return res, nil
+}
+
+// factor is a factor of a product, its base raised to its exponent
+type factor struct {
+	base exprNode
+	key  string // base as text
+	exp  *big.Rat
+}
+
+// product is a rational coefficient times factors of distinct bases
+type product struct {
+	s       *simplifier
+	col     int
+	op      string
+	coeff   *big.Rat
+	factors []*factor
+	byBase  map[string]*factor
+}
+
+func newProduct(s *simplifier, col int, op string) *product {
+	return &product{s: s, col: col, op: op, coeff: big.NewRat(1, 1), byBase: map[string]*factor{}}
+}
+
+// multiply multiplies the product by n^exp, n being simplified. Products,
+// quotients and powers in n are only taken apart for integer exponents, as
+// (x^2)^(1/2) is not x when x is negative.
+func (p *product) multiply(n exprNode, exp *big.Rat) error {
+	integer := exp.IsInt()
+	if x, ok := ratValue(n); ok && integer {
+		res, err := bigPower(x, exp)
+		if err != nil {
+			if x.Sign() == 0 {
+				return errorAt(p.col, "division by zero")
+			}
+			return errorAt(p.col, "%v", err)
+		}
+		p.coeff.Mul(p.coeff, res)
+		return p.s.check(p.col, p.op, p.coeff)
+	}
+
+	switch m := n.(type) {
+	case *unaryNode:
+		if integer {
+			if exp.Num().Bit(0) == 1 {
+				p.coeff.Neg(p.coeff)
+			}
+			return p.multiply(m.x, exp)
+		}
+	case *binaryNode:
+		if !integer {
+			break
+		}
+		switch m.op {
+		case '*', '/':
+			if err := p.multiply(m.x, exp); err != nil {
+				return err
+			}
+			if m.op == '/' {
+				exp = new(big.Rat).Neg(exp)
+			}
+			return p.multiply(m.y, exp)
+		case '^':
+			if y, ok := ratValue(m.y); ok {
+				return p.multiply(m.x, new(big.Rat).Mul(exp, y))
+			}
+		}
+	}
+
+	key := formatExpr(n)
+	if f, ok := p.byBase[key]; ok {
+		f.exp.Add(f.exp, exp)
+		return nil
+	}
+	f := &factor{base: n, key: key, exp: new(big.Rat).Set(exp)}
+	p.factors = append(p.factors, f)
+	p.byBase[key] = f
+	return nil
+}
+
+// finish drops the factors whose exponents cancelled out and multiplies the
+// coefficient by the numbers raised to integer powers
+func (p *product) finish() error {
+	factors := p.factors[:0]
+	for _, f := range p.factors {
+		if f.exp.Sign() != 0 {
+			if _, ok := ratValue(f.base); ok && f.exp.IsInt() {
+				if err := p.multiply(f.base, f.exp); err != nil {
+					return err
+				}
+			} else {
+				factors = append(factors, f)
+				continue
+			}
+		}
+		delete(p.byBase, f.key)
+	}
+	p.factors = factors
+	return nil
+}
+
+// key identifies the factors of the product whatever their order
+func (p *product) key() string {
+	keys := make([]string, len(p.factors))
+	for i, f := range p.factors {
+		keys[i] = f.key + "^" + f.exp.RatString()
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "*")
+}
+
+// build writes the product as its numerator over its denominator, as in 3*x/(2*y^2)
+func (p *product) build() exprNode {
+	if len(p.factors) == 0 || p.coeff.Sign() == 0 {
+		return ratNode(p.col, p.coeff)
+	}
+
+	var num, den []exprNode
+	coeff := new(big.Rat).Abs(p.coeff)
+	if !isOne(coeff.Num()) {
+		num = append(num, intNode(p.col, coeff.Num()))
+	}
+	if !isOne(coeff.Denom()) {
+		den = append(den, intNode(p.col, coeff.Denom()))
+	}
+	for _, f := range p.factors {
+		if f.exp.Sign() > 0 {
+			num = append(num, powerNode(p.col, f.base, f.exp))
+		} else {
+			den = append(den, powerNode(p.col, f.base, new(big.Rat).Neg(f.exp)))
+		}
+	}
+	if len(num) == 0 {
+		num = append(num, intNode(p.col, big.NewInt(1)))
+	}
+
+	res := chainNodes(p.col, '*', num)
+	if len(den) > 0 {
+		res = &binaryNode{col: p.col, op: '/', x: res, y: chainNodes(p.col, '*', den)}
+	}
+	if p.coeff.Sign() < 0 {
+		res = &unaryNode{col: p.col, op: '-', x: res}
+	}
+	return res
+}
+
+// product simplifies a product or quotient n whose operands are simplified
+func (s *simplifier) product(n *binaryNode) (exprNode, error) {
+	p := newProduct(s, n.col, string(n.op))
+	if err := p.multiply(n, big.NewRat(1, 1)); err != nil {
+		return nil, err
+	}
+	if err := p.finish(); err != nil {
+		return nil, err
+	}
+	return p.build(), nil
+}
+
+// power simplifies x^y whose operands are simplified
+func (s *simplifier) power(n *binaryNode) (exprNode, error) {
+	x, isNumber := ratValue(n.x)
+	y, constExp := ratValue(n.y)
+	switch {
+	case constExp && y.Sign() == 0:
+		// 0^0 is 1, as in the real domain
+		return ratNode(n.col, big.NewRat(1, 1)), nil
+	case isNumber && x.Cmp(big.NewRat(1, 1)) == 0:
+		return n.x, nil
+	case isNumber && x.Sign() == 0 && constExp && y.Sign() > 0:
+		return n.x, nil
+	case !constExp:
+		return n, nil
+	}
+	return s.product(n)
+}
+
+// modulo computes x % y of numbers, leaving it as it is otherwise
+func (s *simplifier) modulo(n *binaryNode) (exprNode, error) {
+	x, ok := ratValue(n.x)
+	if !ok {
+		return n, nil
+	}
+	y, ok := ratValue(n.y)
+	if !ok {
+		return n, nil
+	}
+	if y.Sign() == 0 {
+		return nil, errorAt(n.col, "modulo by zero")
+	}
+	// the remainder has the sign of x, as math.Mod
+	q := new(big.Rat).Quo(x, y)
+	trunc := new(big.Int).Quo(q.Num(), q.Denom())
+	res := new(big.Rat).Sub(x, new(big.Rat).Mul(y, new(big.Rat).SetInt(trunc)))
+	return ratNode(n.col, res), nil
+}
+
+// call simplifies the arguments of a call to a builtin and computes the
+// result when it is an exact rational, as floor(2.5) or sqrt(16)
+func (s *simplifier) call(n *callNode) (exprNode, error) {
+	f, ok := builtins[n.name]
+	if !ok {
+		return nil, errorAt(n.col, "unknown function %v", n.name)
+	}
+	if len(n.args) < f.minArgs || (f.maxArgs >= 0 && len(n.args) > f.maxArgs) {
+		return nil, errorAt(n.col, "%v expects %v, got %d", n.name, arity(f.minArgs, f.maxArgs), len(n.args))
+	}
+
+	m := &callNode{col: n.col, name: n.name, args: make([]exprNode, len(n.args))}
+	constArgs := true
+	for i, arg := range n.args {
+		x, err := s.simplify(arg)
+		if err != nil {
+			return nil, err
+		}
+		m.args[i] = x
+		_, ok := ratValue(x)
+		constArgs = constArgs && ok
+	}
+
+	if constArgs {
+		if x, err := s.exact.evalCall(m); err == nil {
+			return ratNode(n.col, x), nil
+		}
+		// functions of numbers without an exact value stay as they are, unless
+		// they are undefined or have an integer value at 0 or 1, as cos(0)
+		x, err := (&evaluator{}).evalCall(m)
+		if err != nil {
+			return nil, err
+		}
+		if x == math.Trunc(x) && zeroOrOne(m.args) {
+			return ratNode(n.col, new(big.Rat).SetFloat64(x)), nil
+		}
+		return m, nil
+	}
+
+	// ln(e) = 1 and ln(exp(x)) = x
+	if (n.name == "ln" || n.name == "log") && len(m.args) == 1 {
+		switch arg := m.args[0].(type) {
+		case *identNode:
+			if arg.name == "e" {
+				return ratNode(n.col, big.NewRat(1, 1)), nil
+			}
+		case *callNode:
+			if arg.name == "exp" {
+				return arg.args[0], nil
+			}
+		}
+	}
+	return m, nil
+}
+
+func zeroOrOne(args []exprNode) bool {
+	for _, arg := range args {
+		x, _ := ratValue(arg)
+		if x.Sign() != 0 && x.Cmp(big.NewRat(1, 1)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ratValue returns the value of a number written by ratNode
+func ratValue(n exprNode) (*big.Rat, bool) {
+	switch n := n.(type) {
+	case *numberNode:
+		x, err := parseBigNumber(&calculatorpb.BigNumber{Value: n.text})
+		return x, err == nil
+	case *unaryNode:
+		if x, ok := ratValue(n.x); ok {
+			return x.Neg(x), true
+		}
+	case *binaryNode:
+		num, numOk := n.x.(*numberNode)
+		den, denOk := n.y.(*numberNode)
+		if n.op == '/' && numOk && denOk && den.value != 0 {
+			x, _ := ratValue(num)
+			y, _ := ratValue(den)
+			if x != nil && y != nil {
+				return x.Quo(x, y), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// ratNode writes x as a decimal when it has a finite decimal expansion and as
+// a fraction otherwise, negated when it is negative
+func ratNode(col int, x *big.Rat) exprNode {
+	if x.Sign() < 0 {
+		return &unaryNode{col: col, op: '-', x: ratNode(col, new(big.Rat).Neg(x))}
+	}
+	if x.IsInt() {
+		return intNode(col, x.Num())
+	}
+	if digits, ok := decimalDigits(x.Denom()); ok {
+		f, _ := x.Float64()
+		return &numberNode{col: col, text: x.FloatString(digits), value: f}
+	}
+	return &binaryNode{col: col, op: '/', x: intNode(col, x.Num()), y: intNode(col, x.Denom())}
+}
+
+func intNode(col int, x *big.Int) *numberNode {
+	f, _ := new(big.Float).SetInt(x).Float64()
+	return &numberNode{col: col, text: x.String(), value: f}
+}
+
+// decimalDigits returns the digits after the decimal point of the fractions
+// with denominator den, when den has no prime factor but 2 and 5
+func decimalDigits(den *big.Int) (int, bool) {
+	twos := int(den.TrailingZeroBits())
+	rest := new(big.Int).Rsh(den, uint(twos))
+	five, mod := big.NewInt(5), new(big.Int)
+	fives := 0
+	for !isOne(rest) {
+		if rest.QuoRem(rest, five, mod); mod.Sign() != 0 {
+			return 0, false
+		}
+		fives++
+	}
+	if fives > twos {
+		return fives, true
+	}
+	return twos, true
+}
+
+func isOne(x *big.Int) bool {
+	return x.IsInt64() && x.Int64() == 1
+}
+
+// powerNode writes base^exp, or base when exp is 1
+func powerNode(col int, base exprNode, exp *big.Rat) exprNode {
+	if exp.Cmp(big.NewRat(1, 1)) == 0 {
+		return base
+	}
+	return &binaryNode{col: col, op: '^', x: base, y: ratNode(col, exp)}
+}
+
+// chainNodes joins nodes with the left associative op
+func chainNodes(col int, op byte, nodes []exprNode) exprNode {
+	res := nodes[0]
+	for _, n := range nodes[1:] {
+		res = &binaryNode{col: col, op: op, x: res, y: n}
+	}
+	return res
+}
+
+// differentiator differentiates simplified expression trees with respect to
+// a variable. The nodes of a derivative have the column of the node they
+// derive from, which errors in simplifying the derivative refer to.
+type differentiator struct {
+	variable string
+}
+
+// depends reports whether n contains the variable
+func (d *differentiator) depends(n exprNode) bool {
+	switch n := n.(type) {
+	case *identNode:
+		return n.name == d.variable
+	case *unaryNode:
+		return d.depends(n.x)
+	case *binaryNode:
+		return d.depends(n.x) || d.depends(n.y)
+	case *callNode:
+		for _, arg := range n.args {
+			if d.depends(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *differentiator) derive(n exprNode) (exprNode, error) {
+	col := n.pos()
+	if !d.depends(n) {
+		return ratNode(col, new(big.Rat)), nil
+	}
+
+	switch n := n.(type) {
+	case *identNode:
+		return ratNode(col, big.NewRat(1, 1)), nil
+	case *unaryNode:
+		dx, err := d.derive(n.x)
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{col: col, op: '-', x: dx}, nil
+	case *binaryNode:
+		return d.deriveBinary(n)
+	case *callNode:
+		return d.deriveCall(n)
+	}
+	return nil, errorAt(col, "cannot differentiate %T", n)
+}
+
+func (d *differentiator) deriveBinary(n *binaryNode) (exprNode, error) {
+	col, x, y := n.col, n.x, n.y
+	bin := func(op byte, x, y exprNode) exprNode { return &binaryNode{col: col, op: op, x: x, y: y} }
+	number := func(x int64) exprNode { return ratNode(col, big.NewRat(x, 1)) }
+
+	dx, err := d.derive(x)
+	if err != nil {
+		return nil, err
+	}
+	dy, err := d.derive(y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case '+', '-':
+		return bin(n.op, dx, dy), nil
+	case '*':
+		return bin('+', bin('*', dx, y), bin('*', x, dy)), nil
+	case '/':
+		return bin('/', bin('-', bin('*', dx, y), bin('*', x, dy)), bin('^', y, number(2))), nil
+	case '%':
+		// x % c is x minus a multiple of c that is constant almost everywhere
+		if d.depends(y) {
+			return nil, errorAt(col, "%% is not differentiable in its right operand")
+		}
+		return dx, nil
+	case '^':
+		switch {
+		case !d.depends(y):
+			return bin('*', bin('*', y, bin('^', x, bin('-', y, number(1)))), dx), nil
+		case isIdent(x, "e"):
+			return bin('*', n, dy), nil
+		case !d.depends(x):
+			return bin('*', bin('*', n, &callNode{col: col, name: "ln", args: []exprNode{x}}), dy), nil
+		}
+		// x^y = e^(y*ln(x))
+		ln := &callNode{col: col, name: "ln", args: []exprNode{x}}
+		return bin('*', n, bin('+', bin('*', dy, ln), bin('/', bin('*', y, dx), x))), nil
+	}
+	return nil, errorAt(col, "unknown operator %q", n.op)
+}
+
+func (d *differentiator) deriveCall(n *callNode) (exprNode, error) {
+	col, x := n.col, n.args[0]
+	bin := func(op byte, x, y exprNode) exprNode { return &binaryNode{col: col, op: op, x: x, y: y} }
+	call := func(name string, args ...exprNode) exprNode { return &callNode{col: col, name: name, args: args} }
+	number := func(x int64) exprNode { return ratNode(col, big.NewRat(x, 1)) }
+	neg := func(x exprNode) exprNode { return &unaryNode{col: col, op: '-', x: x} }
+
+	if n.name == "log" && len(n.args) == 2 {
+		// log(x, b) = ln(x)/ln(b)
+		return d.derive(bin('/', call("ln", x), call("ln", n.args[1])))
+	}
+
+	dx, err := d.derive(x)
+	if err != nil {
+		return nil, err
+	}
+	var res exprNode
+	switch n.name {
+	case "sqrt":
+		res = bin('/', dx, bin('*', number(2), n))
+	case "cbrt":
+		res = bin('/', dx, bin('*', number(3), bin('^', n, number(2))))
+	case "abs":
+		res = bin('/', bin('*', dx, x), n)
+	case "exp":
+		res = bin('*', n, dx)
+	case "ln", "log":
+		res = bin('/', dx, x)
+	case "log2":
+		res = bin('/', dx, bin('*', x, call("ln", number(2))))
+	case "log10":
+		res = bin('/', dx, bin('*', x, call("ln", number(10))))
+	case "sin":
+		res = bin('*', call("cos", x), dx)
+	case "cos":
+		res = neg(bin('*', call("sin", x), dx))
+	case "tan":
+		res = bin('/', dx, bin('^', call("cos", x), number(2)))
+	case "asin":
+		res = bin('/', dx, call("sqrt", bin('-', number(1), bin('^', x, number(2)))))
+	case "acos":
+		res = neg(bin('/', dx, call("sqrt", bin('-', number(1), bin('^', x, number(2))))))
+	case "atan":
+		res = bin('/', dx, bin('+', number(1), bin('^', x, number(2))))
+	case "sinh":
+		res = bin('*', call("cosh", x), dx)
+	case "cosh":
+		res = bin('*', call("sinh", x), dx)
+	case "tanh":
+		res = bin('/', dx, bin('^', call("cosh", x), number(2)))
+	default:
+		// floor, ceil, round, min and max
+		return nil, errorAt(col, "%v is not differentiable", n.name)
+	}
+	return res, nil
+}
+
+func isIdent(n exprNode, name string) bool {
+	ident, ok := n.(*identNode)
+	return ok && ident.name == name
+}
+
+// Precedence of the nodes when written, from the loosest
+const (
+	precSum = iota + 1
+	precProduct
+	precUnary
+	precPower
+	precPrimary
+)
+
+func precedence(n exprNode) int {
+	switch n := n.(type) {
+	case *unaryNode:
+		return precUnary
+	case *binaryNode:
+		switch n.op {
+		case '+', '-':
+			return precSum
+		case '^':
+			return precPower
+		}
+		return precProduct
+	}
+	return precPrimary
+}
+
+// formatExpr writes n with the parentheses it needs, as in 3*x^2 - 2*(x + 1)
+func formatExpr(n exprNode) string {
+	var b strings.Builder
+	writeExpr(&b, n)
+	return b.String()
+}
+
+func writeExpr(b *strings.Builder, n exprNode) {
+	switch n := n.(type) {
+	case *numberNode:
+		b.WriteString(n.text)
+	case *identNode:
+		b.WriteString(n.name)
+	case *unaryNode:
+		// -x*y is (-x)*y, which has the same value as -(x*y)
+		b.WriteByte('-')
+		writeOperand(b, n.x, precProduct, false)
+	case *binaryNode:
+		switch n.op {
+		case '+', '-':
+			writeOperand(b, n.x, precSum, false)
+			fmt.Fprintf(b, " %c ", n.op)
+			writeOperand(b, n.y, precProduct, true)
+		case '^':
+			// right associative
+			writeOperand(b, n.x, precPrimary, false)
+			b.WriteByte('^')
+			writeOperand(b, n.y, precPower, true)
+		default:
+			writeOperand(b, n.x, precProduct, false)
+			b.WriteByte(n.op)
+			writeOperand(b, n.y, precPower, true)
+		}
+	case *callNode:
+		b.WriteString(n.name)
+		b.WriteByte('(')
+		for i, arg := range n.args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeExpr(b, arg)
+		}
+		b.WriteByte(')')
+	}
+}
+
+// writeOperand writes n in parentheses when it binds looser than min, or
+// when it is a negation on the right of an operator, as in x - (-y)
+func writeOperand(b *strings.Builder, n exprNode, min int, right bool) {
+	_, negation := n.(*unaryNode)
+	if precedence(n) < min || (right && negation) {
+		b.WriteByte('(')
+		writeExpr(b, n)
+		b.WriteByte(')')
+		return
+	}
+	writeExpr(b, n)
+}
+
+// expressionTree converts n to its message
+func expressionTree(n exprNode) *calculatorpb.ExpressionNode {
+	switch n := n.(type) {
+	case *numberNode:
+		return &calculatorpb.ExpressionNode{Kind: calculatorpb.ExpressionKind_EXPRESSION_KIND_NUMBER, Number: n.value}
+	case *identNode:
+		return &calculatorpb.ExpressionNode{Kind: calculatorpb.ExpressionKind_EXPRESSION_KIND_SYMBOL, Name: n.name}
+	case *unaryNode:
+		return &calculatorpb.ExpressionNode{
+			Kind:     calculatorpb.ExpressionKind_EXPRESSION_KIND_NEGATE,
+			Operands: []*calculatorpb.ExpressionNode{expressionTree(n.x)},
+		}
+	case *binaryNode:
+		return &calculatorpb.ExpressionNode{
+			Kind:     calculatorpb.ExpressionKind_EXPRESSION_KIND_BINARY,
+			Operator: string(n.op),
+			Operands: []*calculatorpb.ExpressionNode{expressionTree(n.x), expressionTree(n.y)},
+		}
+	case *callNode:
+		res := &calculatorpb.ExpressionNode{Kind: calculatorpb.ExpressionKind_EXPRESSION_KIND_CALL, Name: n.name}
+		for _, arg := range n.args {
+			res.Operands = append(res.Operands, expressionTree(arg))
+		}
+		return res
+	}
+	return &calculatorpb.ExpressionNode{}
+}
+
+func countNodes(n exprNode) int {
+	switch n := n.(type) {
+	case *unaryNode:
+		return 1 + countNodes(n.x)
+	case *binaryNode:
+		return 1 + countNodes(n.x) + countNodes(n.y)
+	case *callNode:
+		count := 1
+		for _, arg := range n.args {
+			count += countNodes(arg)
+		}
+		return count
+	}
+	return 1
+}
+
+// parseSymbolic parses an expression to simplify or differentiate
+func parseSymbolic(expression string) (exprNode, error) {
+	node, err := parseExpr(expression)
+	if err != nil {
+		return nil, invalidArgument("expression", err)
+	}
+	if countNodes(node) > maxSymbolicNodes {
+		return nil, invalidArgument("expression", fmt.Errorf("expressions of more than %d numbers, names and operations are not supported", maxSymbolicNodes))
+	}
+	return node, nil
+}
+
+func symbolicError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return invalidArgument("expression", err)
+}
+
+func (*server) Simplify(ctx context.Context, in *calculatorpb.SimplifyRequest) (*calculatorpb.SimplifyResponse, error) {
+	fmt.Printf("Received Simplify RPC: %v\n", in)
+
+	node, err := parseSymbolic(in.GetExpression())
+	if err != nil {
+		return nil, err
+	}
+	res, err := (&simplifier{ctx: ctx}).simplify(node)
+	if err != nil {
+		return nil, symbolicError(ctx, err)
+	}
+	return &calculatorpb.SimplifyResponse{
+		Result: formatExpr(res),
+		Tree:   expressionTree(res),
+	}, nil
+}
+
+func (*server) Differentiate(ctx context.Context, in *calculatorpb.DifferentiateRequest) (*calculatorpb.DifferentiateResponse, error) {
+	fmt.Printf("Received Differentiate RPC: %v\n", in)
+
+	variable, err := checkVariable(in.GetVariable())
+	if err != nil {
+		return nil, invalidArgument("variable", err)
+	}
+	node, err := parseSymbolic(in.GetExpression())
+	if err != nil {
+		return nil, err
+	}
+
+	// simplifying first reports errors such as 1/0 at their column in the expression
+	s := &simplifier{ctx: ctx}
+	node, err = s.simplify(node)
+	if err != nil {
+		return nil, symbolicError(ctx, err)
+	}
+	derivative, err := (&differentiator{variable: variable}).derive(node)
+	if err != nil {
+		return nil, symbolicError(ctx, err)
+	}
+	res, err := s.simplify(derivative)
+	if err != nil {
+		return nil, symbolicError(ctx, err)
+	}
+	return &calculatorpb.DifferentiateResponse{
+		Derivative: formatExpr(res),
+		Tree:       expressionTree(res),
+	}, nil
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// defaultUnits is the unit table used unless the server is started with -units
+//
+//go:embed units.json
+var defaultUnits []byte
+
+// units is the unit table of Convert and EvaluateQuantity, loaded by main
+var units *unitTable
+
+// unit converts between its values and the base unit of its dimension:
+// base = value * factor + offset
+type unit struct {
+	Symbol    string  `json:"symbol"`
+	Name      string  `json:"name"`
+	Dimension string  `json:"dimension"`
+	Factor    float64 `json:"factor"`
+	Offset    float64 `json:"offset"` // non-zero for temperature scales such as degC
+}
+
+func (u *unit) toBase(v float64) float64   { return v*u.Factor + u.Offset }
+func (u *unit) fromBase(x float64) float64 { return (x - u.Offset) / u.Factor }
+
+type unitTable struct {
+	bySymbol map[string]*unit
+}
+
+// loadUnitTable reads the unit table from the JSON file at path,
+// or the built-in table when path is empty
+func loadUnitTable(path string) (*unitTable, error) {
+	data := defaultUnits
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("reading unit table: %v", err)
+		}
+	}
+	return parseUnitTable(data)
+}
+
+func parseUnitTable(data []byte) (*unitTable, error) {
+	var file struct {
+		Units []*unit `json:"units"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing unit table: %v", err)
+	}
+
+	t := &unitTable{bySymbol: map[string]*unit{}}
+	for i, u := range file.Units {
+		tokens, err := tokenize(u.Symbol)
+		if err != nil || len(tokens) != 2 || tokens[0].kind != tokIdent {
+			return nil, fmt.Errorf("unit %d: symbol %q is not a name", i+1, u.Symbol)
+		}
+		if _, ok := t.bySymbol[u.Symbol]; ok {
+			return nil, fmt.Errorf("unit %d: symbol %v is defined twice", i+1, u.Symbol)
+		}
+		if u.Dimension == "" {
+			return nil, fmt.Errorf("unit %v has no dimension", u.Symbol)
+		}
+		if !(u.Factor > 0) || math.IsInf(u.Factor, 0) {
+			return nil, fmt.Errorf("unit %v: factor %v is not a positive number", u.Symbol, u.Factor)
+		}
+		if math.IsNaN(u.Offset) || math.IsInf(u.Offset, 0) {
+			return nil, fmt.Errorf("unit %v: offset %v is not finite", u.Symbol, u.Offset)
+		}
+		t.bySymbol[u.Symbol] = u
+	}
+	if len(t.bySymbol) == 0 {
+		return nil, errors.New("the unit table is empty")
+	}
+	return t, nil
+}
+
+func (t *unitTable) lookup(symbol string) (*unit, error) {
+	u, ok := t.bySymbol[symbol]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q", symbol)
+	}
+	return u, nil
+}
+
+// quantity is a value in the base unit of its dimension, shown in unit.
+// A nil unit is a plain number.
+type quantity struct {
+	base float64
+	unit *unit
+}
+
+func (q quantity) dimension() string {
+	if q.unit == nil {
+		return "plain number"
+	}
+	return q.unit.Dimension
+}
+
+// dimensionError reports quantities whose dimensions cannot be combined
+type dimensionError struct {
+	col         int // 0 when the error is not about a part of an expression
+	msg         string
+	left, right string
+}
+
+func (e *dimensionError) Error() string {
+	if e.col == 0 {
+		return e.msg
+	}
+	return fmt.Sprintf("column %d: %s", e.col, e.msg)
+}
+
+func incompatible(col int, op string, left, right quantity) *dimensionError {
+	return &dimensionError{
+		col:   col,
+		msg:   fmt.Sprintf("cannot %v %v and %v", op, left.dimension(), right.dimension()),
+		left:  left.dimension(),
+		right: right.dimension(),
+	}
+}
+
+// quantityEvaluator evaluates quantity expressions. Quantities keep the unit
+// of the left operand, and scales with an offset, such as degC, can only be
+// converted since sums and multiples of them depend on the scale.
+type quantityEvaluator struct {
+	table *unitTable
+}
+
+func (e *quantityEvaluator) eval(n exprNode) (quantity, error) {
+	switch n := n.(type) {
+	case *numberNode:
+		return quantity{base: n.value}, nil
+	case *quantityNode:
+		u, err := e.table.lookup(n.unit)
+		if err != nil {
+			return quantity{}, errorAt(n.unitCol, "%v", err)
+		}
+		return quantity{base: u.toBase(n.value), unit: u}, nil
+	case *identNode:
+		if v, ok := constants[n.name]; ok {
+			return quantity{base: v}, nil
+		}
+		return quantity{}, errorAt(n.col, "unknown constant %v, units must follow a number", n.name)
+	case *unaryNode:
+		x, err := e.eval(n.x)
+		if err != nil {
+			return quantity{}, err
+		}
+		if err := checkNoOffset(n.col, x); err != nil {
+			return quantity{}, err
+		}
+		return quantity{base: -x.base, unit: x.unit}, nil
+	case *binaryNode:
+		return e.evalBinary(n)
+	case *callNode:
+		return e.evalCall(n)
+	}
+	return quantity{}, errorAt(n.pos(), "cannot evaluate %T", n)
+}
+
+func checkNoOffset(col int, q quantity) error {
+	if q.unit != nil && q.unit.Offset != 0 {
+		return errorAt(col, "%v can only be converted, use a scale without an offset such as K for arithmetic", q.unit.Symbol)
+	}
+	return nil
+}
+
+func (e *quantityEvaluator) evalBinary(n *binaryNode) (quantity, error) {
+	x, err := e.eval(n.x)
+	if err != nil {
+		return quantity{}, err
+	}
+	y, err := e.eval(n.y)
+	if err != nil {
+		return quantity{}, err
+	}
+	if err := checkNoOffset(n.col, x); err != nil {
+		return quantity{}, err
+	}
+	if err := checkNoOffset(n.col, y); err != nil {
+		return quantity{}, err
+	}
+
+	res := quantity{unit: x.unit}
+	switch n.op {
+	case '+', '-':
+		if x.dimension() != y.dimension() {
+			verb := map[byte]string{'+': "add", '-': "subtract"}[n.op]
+			return quantity{}, incompatible(n.col, verb, x, y)
+		}
+		res.base = x.base + y.base
+		if n.op == '-' {
+			res.base = x.base - y.base
+		}
+	case '*':
+		if x.unit != nil && y.unit != nil {
+			err := incompatible(n.col, "multiply", x, y)
+			err.msg += ", products of quantities are not supported"
+			return quantity{}, err
+		}
+		if res.unit == nil {
+			res.unit = y.unit
+		}
+		res.base = x.base * y.base
+	case '/':
+		if y.base == 0 {
+			return quantity{}, errorAt(n.col, "division by zero")
+		}
+		switch {
+		case y.unit == nil:
+		case x.unit != nil && x.unit.Dimension == y.unit.Dimension:
+			// the ratio of two lengths is a plain number
+			res.unit = nil
+		default:
+			return quantity{}, incompatible(n.col, "divide", x, y)
+		}
+		res.base = x.base / y.base
+	case '%', '^':
+		if x.unit != nil || y.unit != nil {
+			return quantity{}, errorAt(n.col, "%q only applies to plain numbers", n.op)
+		}
+		if n.op == '%' {
+			if y.base == 0 {
+				return quantity{}, errorAt(n.col, "modulo by zero")
+			}
+			res.base = math.Mod(x.base, y.base)
+		} else {
+			res.base = math.Pow(x.base, y.base)
+		}
+	default:
+		return quantity{}, errorAt(n.col, "unknown operator %q", n.op)
+	}
+
+	if _, err := checkResult(n.col, string(n.op), res.base); err != nil {
+		return quantity{}, err
+	}
+	return res, nil
+}
+
+// evalCall applies a builtin function to plain numbers
+func (e *quantityEvaluator) evalCall(n *callNode) (quantity, error) {
+	f, ok := builtins[n.name]
+	if !ok {
+		return quantity{}, errorAt(n.col, "unknown function %v", n.name)
+	}
+	if len(n.args) < f.minArgs || (f.maxArgs >= 0 && len(n.args) > f.maxArgs) {
+		return quantity{}, errorAt(n.col, "%v expects %v, got %d", n.name, arity(f.minArgs, f.maxArgs), len(n.args))
+	}
+
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		v, err := e.eval(arg)
+		if err != nil {
+			return quantity{}, err
+		}
+		if v.unit != nil {
+			return quantity{}, errorAt(arg.pos(), "%v only applies to plain numbers, not %v", n.name, v.unit.Symbol)
+		}
+		args[i] = v.base
+	}
+	res, err := checkResult(n.col, n.name, f.fn(args))
+	return quantity{base: res}, err
+}
+
+// express writes q in the unit with the given symbol, or in its own unit when symbol is empty
+func (t *unitTable) express(q quantity, symbol string) (*calculatorpb.Quantity, error) {
+	u := q.unit
+	if symbol != "" {
+		var err error
+		if u, err = t.lookup(symbol); err != nil {
+			return nil, err
+		}
+		if q.unit == nil || q.unit.Dimension != u.Dimension {
+			return nil, &dimensionError{
+				msg:   fmt.Sprintf("cannot convert a %v to %v, a %v", q.dimension(), u.Symbol, u.Dimension),
+				left:  q.dimension(),
+				right: u.Dimension,
+			}
+		}
+	}
+	if u == nil {
+		return &calculatorpb.Quantity{Value: q.base}, nil
+	}
+	return &calculatorpb.Quantity{Value: roundSignificant(u.fromBase(q.base)), Unit: u.Symbol}, nil
+}
+
+// roundSignificant rounds x to 15 significant digits, which drops the noise of
+// converting through the base unit: 100 degC is 212 degF, not 211.99999999999991
+func roundSignificant(x float64) float64 {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(x, 'g', 15, 64), 64)
+	if err != nil {
+		return x
+	}
+	return rounded
+}
+
+// quantityError builds an INVALID_ARGUMENT error for a request field,
+// with an ErrorInfo detail when dimensions were incompatible
+func quantityError(field string, err error) error {
+	var dimErr *dimensionError
+	if !errors.As(err, &dimErr) {
+		return invalidArgument(field, err)
+	}
+
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid %v: %v", field, err))
+	detailed, detailErr := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: "INCOMPATIBLE_DIMENSIONS",
+			Domain: "calculator",
+			Metadata: map[string]string{
+				"left":  dimErr.left,
+				"right": dimErr.right,
+			},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: err.Error()},
+			},
+		},
+	)
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (*server) Convert(ctx context.Context, in *calculatorpb.ConvertRequest) (*calculatorpb.ConvertResponse, error) {
+	fmt.Printf("Received Convert RPC: %v\n", in)
+
+	value := in.GetQuantity().GetValue()
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, invalidArgument("quantity", fmt.Errorf("value %v is not finite", value))
+	}
+	q := quantity{base: value}
+	if symbol := in.GetQuantity().GetUnit(); symbol != "" {
+		u, err := units.lookup(symbol)
+		if err != nil {
+			return nil, invalidArgument("quantity", err)
+		}
+		q = quantity{base: u.toBase(value), unit: u}
+	}
+	if in.GetUnit() == "" {
+		return nil, invalidArgument("unit", errors.New("the unit to convert to is empty"))
+	}
+
+	res, err := units.express(q, in.GetUnit())
+	if err != nil {
+		return nil, quantityError("unit", err)
+	}
+	return &calculatorpb.ConvertResponse{
+		Quantity: res,
+	}, nil
+}
+
+func (*server) EvaluateQuantity(ctx context.Context, in *calculatorpb.EvaluateQuantityRequest) (*calculatorpb.EvaluateQuantityResponse, error) {
+	fmt.Printf("Received EvaluateQuantity RPC: %v\n", in)
+
+	node, err := parseQuantityExpr(in.GetExpression())
+	if err != nil {
+		return nil, invalidArgument("expression", err)
+	}
+	q, err := (&quantityEvaluator{table: units}).eval(node)
+	if err != nil {
+		return nil, quantityError("expression", err)
+	}
+
+	res, err := units.express(q, in.GetUnit())
+	if err != nil {
+		return nil, quantityError("unit", err)
+	}
+	return &calculatorpb.EvaluateQuantityResponse{
+		Result: res,
+	}, nil
+}
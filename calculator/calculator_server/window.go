@@ -0,0 +1,384 @@
+package main
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+// Limits of a window configuration. maxWindowElements also bounds time
+// windows, which hold however many numbers arrive within their duration.
+const (
+	maxWindowCount    = 1 << 20
+	maxWindowTopK     = 1000
+	maxWindowElements = maxWindowCount
+)
+
+type windowEntry struct {
+	seq uint64 // position in the stream, identifies the entry across deques
+	at  time.Time
+	x   float64
+}
+
+// entryDeque is a double-ended queue of window entries backed by a slice.
+// Popped entries at the front are reclaimed once they make up half of it.
+type entryDeque struct {
+	items []windowEntry
+	head  int
+}
+
+func (d *entryDeque) len() int               { return len(d.items) - d.head }
+func (d *entryDeque) front() windowEntry     { return d.items[d.head] }
+func (d *entryDeque) back() windowEntry      { return d.items[len(d.items)-1] }
+func (d *entryDeque) pushBack(e windowEntry) { d.items = append(d.items, e) }
+func (d *entryDeque) popBack()               { d.items = d.items[:len(d.items)-1] }
+
+func (d *entryDeque) popFront() {
+	d.head++
+	if d.head > 32 && d.head*2 >= len(d.items) {
+		d.items = append(d.items[:0], d.items[d.head:]...)
+		d.head = 0
+	}
+}
+
+// entryHeap is a heap of window entries ordered by less, for container/heap
+type entryHeap struct {
+	items []windowEntry
+	less  func(a, b windowEntry) bool
+}
+
+func (h *entryHeap) Len() int           { return len(h.items) }
+func (h *entryHeap) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *entryHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *entryHeap) Push(x any)         { h.items = append(h.items, x.(windowEntry)) }
+
+func (h *entryHeap) Pop() any {
+	e := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return e
+}
+
+// entryAbove orders entries by value, and by position for equal values, so
+// that every entry of a stream is distinct
+func entryAbove(a, b windowEntry) bool {
+	return a.x > b.x || a.x == b.x && a.seq > b.seq
+}
+
+// topKWindow keeps the k largest numbers of a window in a min-heap and the
+// others in a max-heap, so each number costs O(log n) to add or evict.
+// Evicted numbers are only forgotten in live and dropped from a heap once
+// they reach its root, or when the heaps are rebuilt because they make up
+// half of them.
+type topKWindow struct {
+	k     int
+	top   entryHeap       // the k largest numbers, smallest at the root
+	rest  entryHeap       // the other numbers, largest at the root
+	live  map[uint64]bool // the numbers in the window, true for those in top
+	sizes [2]int          // live numbers in rest and top
+}
+
+func newTopKWindow(k int) *topKWindow {
+	return &topKWindow{
+		k:    k,
+		top:  entryHeap{less: func(a, b windowEntry) bool { return entryAbove(b, a) }},
+		rest: entryHeap{less: entryAbove},
+		live: map[uint64]bool{},
+	}
+}
+
+// root drops the evicted numbers at the root of h and returns whether a
+// live one is left there
+func (t *topKWindow) root(h *entryHeap) bool {
+	for h.Len() > 0 {
+		if _, ok := t.live[h.items[0].seq]; ok {
+			return true
+		}
+		heap.Pop(h)
+	}
+	return false
+}
+
+func (t *topKWindow) push(e windowEntry, inTop bool) {
+	if inTop {
+		heap.Push(&t.top, e)
+		t.sizes[1]++
+	} else {
+		heap.Push(&t.rest, e)
+		t.sizes[0]++
+	}
+	t.live[e.seq] = inTop
+}
+
+func (t *topKWindow) add(e windowEntry) {
+	// top only has fewer than k numbers when rest has none
+	if t.sizes[1] < t.k {
+		t.push(e, true)
+		return
+	}
+	t.root(&t.top)
+	if !entryAbove(e, t.top.items[0]) {
+		t.push(e, false)
+		return
+	}
+	low := heap.Pop(&t.top).(windowEntry)
+	t.sizes[1]--
+	t.push(low, false)
+	t.push(e, true)
+}
+
+func (t *topKWindow) evict(e windowEntry) {
+	inTop := t.live[e.seq]
+	delete(t.live, e.seq)
+	if !inTop {
+		t.sizes[0]--
+	} else {
+		t.sizes[1]--
+		if t.root(&t.rest) {
+			high := heap.Pop(&t.rest).(windowEntry)
+			t.sizes[0]--
+			t.push(high, true)
+		}
+	}
+
+	if t.top.Len()+t.rest.Len() > 2*len(t.live)+64 {
+		for _, h := range []*entryHeap{&t.top, &t.rest} {
+			items := h.items[:0]
+			for _, e := range h.items {
+				if _, ok := t.live[e.seq]; ok {
+					items = append(items, e)
+				}
+			}
+			h.items = items
+			heap.Init(h)
+		}
+	}
+}
+
+// values returns the k largest numbers, largest first
+func (t *topKWindow) values() []float64 {
+	entries := []windowEntry{}
+	for _, e := range t.top.items {
+		if _, ok := t.live[e.seq]; ok {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entryAbove(entries[i], entries[j]) })
+	res := make([]float64, len(entries))
+	for i, e := range entries {
+		res[i] = e.x
+	}
+	return res
+}
+
+// compensatedSum is a running sum with Neumaier's compensation, which keeps
+// the rounding errors of numbers added and removed out of the result
+type compensatedSum struct {
+	sum, c float64
+}
+
+func (s *compensatedSum) add(x float64) {
+	t := s.sum + x
+	if math.Abs(s.sum) >= math.Abs(x) {
+		s.c += (s.sum - t) + x
+	} else {
+		s.c += (x - t) + s.sum
+	}
+	s.sum = t
+}
+
+func (s *compensatedSum) value() float64 { return s.sum + s.c }
+
+// slidingWindow keeps the numbers of a count or time window and its aggregates.
+// Max and min come from monotonic deques and the sum is updated as numbers
+// enter and leave, so those cost O(1) amortised per number. The sum is
+// recomputed from the window once as many numbers as it holds have left it,
+// which bounds the errors that compensation misses. Top-k costs
+// O(log n) per number and O(k log k) to answer, see topKWindow.
+type slidingWindow struct {
+	maxCount int           // 0 for time windows
+	span     time.Duration // 0 for count windows
+	topK     *topKWindow   // nil unless the aggregate is top-k
+
+	entries entryDeque
+	maxs    entryDeque // decreasing values, the front is the window maximum
+	mins    entryDeque // increasing values, the front is the window minimum
+	sum     compensatedSum
+	evicted int // numbers that left the window since the sum was recomputed
+	nextSeq uint64
+}
+
+func (w *slidingWindow) add(at time.Time, x float64) {
+	e := windowEntry{seq: w.nextSeq, at: at, x: x}
+	w.nextSeq++
+
+	w.entries.pushBack(e)
+	w.sum.add(x)
+	for w.maxs.len() > 0 && w.maxs.back().x <= x {
+		w.maxs.popBack()
+	}
+	w.maxs.pushBack(e)
+	for w.mins.len() > 0 && w.mins.back().x >= x {
+		w.mins.popBack()
+	}
+	w.mins.pushBack(e)
+	if w.topK != nil {
+		w.topK.add(e)
+	}
+
+	for w.entries.len() > 0 && w.expired(w.entries.front(), at) {
+		w.evict()
+	}
+}
+
+func (w *slidingWindow) expired(e windowEntry, now time.Time) bool {
+	if w.maxCount > 0 {
+		return w.entries.len() > w.maxCount
+	}
+	return !e.at.After(now.Add(-w.span))
+}
+
+func (w *slidingWindow) evict() {
+	e := w.entries.front()
+	w.entries.popFront()
+
+	w.sum.add(-e.x)
+	w.evicted++
+	if w.evicted >= w.entries.len() {
+		w.sum = compensatedSum{}
+		for i := w.entries.head; i < len(w.entries.items); i++ {
+			w.sum.add(w.entries.items[i].x)
+		}
+		w.evicted = 0
+	}
+	if w.maxs.len() > 0 && w.maxs.front().seq == e.seq {
+		w.maxs.popFront()
+	}
+	if w.mins.len() > 0 && w.mins.front().seq == e.seq {
+		w.mins.popFront()
+	}
+	if w.topK != nil {
+		w.topK.evict(e)
+	}
+}
+
+func newSlidingWindow(cfg *calculatorpb.WindowConfig) (*slidingWindow, error) {
+	w := &slidingWindow{}
+	switch window := cfg.GetWindow().(type) {
+	case *calculatorpb.WindowConfig_Count:
+		if window.Count == 0 || window.Count > maxWindowCount {
+			return nil, fmt.Errorf("count must be in [1, %d]", maxWindowCount)
+		}
+		w.maxCount = int(window.Count)
+	case *calculatorpb.WindowConfig_Duration:
+		if err := window.Duration.CheckValid(); err != nil {
+			return nil, err
+		}
+		if window.Duration.AsDuration() <= 0 {
+			return nil, errors.New("duration must be positive")
+		}
+		w.span = window.Duration.AsDuration()
+	default:
+		return nil, errors.New("either count or duration must be set")
+	}
+
+	switch cfg.GetAggregate() {
+	case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX,
+		calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MIN,
+		calculatorpb.WindowAggregate_WINDOW_AGGREGATE_SUM,
+		calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MEAN:
+	case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_TOP_K:
+		if cfg.GetK() == 0 || cfg.GetK() > maxWindowTopK {
+			return nil, fmt.Errorf("k must be in [1, %d]", maxWindowTopK)
+		}
+		w.topK = newTopKWindow(int(cfg.GetK()))
+	default:
+		return nil, fmt.Errorf("unsupported aggregate %v", cfg.GetAggregate())
+	}
+	return w, nil
+}
+
+func (*server) WindowedAggregate(stream calculatorpb.CalculatorService_WindowedAggregateServer) error {
+	fmt.Println("Received WindowedAggregate RPC")
+
+	req, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	cfg := req.GetConfig()
+	if cfg == nil {
+		return invalidArgument("config", errors.New("the first message must configure the window"))
+	}
+	w, err := newSlidingWindow(cfg)
+	if err != nil {
+		return invalidArgument("config", err)
+	}
+
+	var last time.Time
+	for {
+		req, err := stream.Recv()
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		payload, ok := req.GetPayload().(*calculatorpb.WindowedAggregateRequest_Number)
+		if !ok {
+			return invalidArgument("number", errors.New("only the first message may configure the window"))
+		}
+		x := payload.Number
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return invalidArgument("number", fmt.Errorf("number %v is not finite", x))
+		}
+		at := time.Now()
+		if req.GetTime() != nil {
+			if err := req.GetTime().CheckValid(); err != nil {
+				return invalidArgument("time", err)
+			}
+			at = req.GetTime().AsTime()
+		}
+		if at.Before(last) {
+			return invalidArgument("time", fmt.Errorf("time %v is before the previous number's %v", at, last))
+		}
+		last = at
+
+		w.add(at, x)
+		if w.entries.len() > maxWindowElements {
+			return status.Errorf(codes.ResourceExhausted, fmt.Sprintf("at most %d numbers are supported in a window, shorten the duration", maxWindowElements))
+		}
+
+		res := &calculatorpb.WindowedAggregateResponse{
+			WindowSize: uint32(w.entries.len()),
+		}
+		switch cfg.GetAggregate() {
+		case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX:
+			res.Value = w.maxs.front().x
+		case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MIN:
+			res.Value = w.mins.front().x
+		case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_SUM:
+			res.Value = w.sum.value()
+		case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MEAN:
+			res.Value = w.sum.value() / float64(w.entries.len())
+		case calculatorpb.WindowAggregate_WINDOW_AGGREGATE_TOP_K:
+			res.Top = w.topK.values()
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/minhtran241/grpc-go/calculator/calculatorpb"
+)
+
+func countWindow(count uint32, aggregate calculatorpb.WindowAggregate, k uint32) *calculatorpb.WindowConfig {
+	return &calculatorpb.WindowConfig{
+		Window:    &calculatorpb.WindowConfig_Count{Count: count},
+		Aggregate: aggregate,
+		K:         k,
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	start := time.Unix(0, 0)
+	tests := []struct {
+		name    string
+		cfg     *calculatorpb.WindowConfig
+		numbers []float64
+		step    time.Duration // between the numbers
+		size    int
+		max     float64
+		min     float64
+		sum     float64
+	}{
+		{
+			name:    "count",
+			cfg:     countWindow(3, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_SUM, 0),
+			numbers: []float64{5, 1, 4, 2, 3},
+			size:    3, max: 4, min: 2, sum: 9,
+		},
+		{
+			name: "duration",
+			cfg: &calculatorpb.WindowConfig{
+				Window:    &calculatorpb.WindowConfig_Duration{Duration: durationpb.New(2 * time.Second)},
+				Aggregate: calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX,
+			},
+			numbers: []float64{9, 1, 2, 3},
+			step:    time.Second,
+			size:    2, max: 3, min: 2, sum: 5,
+		},
+		{
+			name:    "cancellation",
+			cfg:     countWindow(2, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_SUM, 0),
+			numbers: []float64{1e17, 1, 1},
+			size:    2, max: 1, min: 1, sum: 2,
+		},
+		{
+			name:    "alternating magnitudes",
+			cfg:     countWindow(1, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_SUM, 0),
+			numbers: []float64{1e300, 1, -1e300, 1e-300, 3},
+			size:    1, max: 3, min: 3, sum: 3,
+		},
+	}
+	for _, tt := range tests {
+		w, err := newSlidingWindow(tt.cfg)
+		if err != nil {
+			t.Fatalf("%v: newSlidingWindow failed: %v", tt.name, err)
+		}
+		at := start
+		for _, x := range tt.numbers {
+			w.add(at, x)
+			at = at.Add(tt.step)
+		}
+		if w.entries.len() != tt.size {
+			t.Errorf("%v: window has %d numbers, want %d", tt.name, w.entries.len(), tt.size)
+		}
+		if got := w.maxs.front().x; got != tt.max {
+			t.Errorf("%v: max = %v, want %v", tt.name, got, tt.max)
+		}
+		if got := w.mins.front().x; got != tt.min {
+			t.Errorf("%v: min = %v, want %v", tt.name, got, tt.min)
+		}
+		if got := w.sum.value(); got != tt.sum {
+			t.Errorf("%v: sum = %v, want %v", tt.name, got, tt.sum)
+		}
+	}
+}
+
+func TestSlidingWindowTopK(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, tt := range []struct{ count, k int }{{1, 1}, {5, 3}, {10, 10}, {20, 4}, {3, 8}} {
+		w, err := newSlidingWindow(countWindow(uint32(tt.count), calculatorpb.WindowAggregate_WINDOW_AGGREGATE_TOP_K, uint32(tt.k)))
+		if err != nil {
+			t.Fatalf("newSlidingWindow failed: %v", err)
+		}
+		var numbers []float64
+		for i := 0; i < 2000; i++ {
+			x := float64(rng.Intn(10))
+			w.add(time.Time{}, x)
+			numbers = append(numbers, x)
+
+			// the k largest of the last count numbers, by brute force
+			from := len(numbers) - tt.count
+			if from < 0 {
+				from = 0
+			}
+			want := append([]float64{}, numbers[from:]...)
+			sort.Sort(sort.Reverse(sort.Float64Slice(want)))
+			if len(want) > tt.k {
+				want = want[:tt.k]
+			}
+			if got := w.topK.values(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("count %d, k %d, after %d numbers: top = %v, want %v", tt.count, tt.k, i+1, got, want)
+			}
+		}
+		// evicted numbers must not pile up in the heaps
+		if n := w.topK.top.Len() + w.topK.rest.Len(); n > 2*tt.count+64 {
+			t.Errorf("count %d, k %d: the heaps hold %d entries", tt.count, tt.k, n)
+		}
+	}
+}
+
+func TestNewSlidingWindowErrors(t *testing.T) {
+	tests := []*calculatorpb.WindowConfig{
+		{Aggregate: calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX},
+		countWindow(0, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX, 0),
+		countWindow(maxWindowCount+1, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX, 0),
+		countWindow(3, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_TOP_K, 0),
+		countWindow(3, calculatorpb.WindowAggregate_WINDOW_AGGREGATE_TOP_K, maxWindowTopK+1),
+		countWindow(3, calculatorpb.WindowAggregate(99), 0),
+		{
+			Window:    &calculatorpb.WindowConfig_Duration{Duration: durationpb.New(-time.Second)},
+			Aggregate: calculatorpb.WindowAggregate_WINDOW_AGGREGATE_MAX,
+		},
+	}
+	for _, cfg := range tests {
+		if _, err := newSlidingWindow(cfg); err == nil {
+			t.Errorf("newSlidingWindow(%v) succeeded, want an error", cfg)
+		}
+	}
+}
@@ -23,17 +23,107 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CalculatorServiceClient interface {
 	// Unary
+	// Sum returns OUT_OF_RANGE with an INTEGER_OVERFLOW ErrorInfo, like
+	// IntegerArithmetic, when the result leaves the int32 range.
+	// This changes its behaviour: it used to wrap around.
 	Sum(ctx context.Context, in *SumRequest, opts ...grpc.CallOption) (*SumResponse, error)
 	// Server Streaming
+	// Streams every prime factor as soon as it is found, not necessarily in
+	// increasing order, see group_factors
 	PrimeNumberDecomposition(ctx context.Context, in *PrimeNumberDecompositionRequest, opts ...grpc.CallOption) (CalculatorService_PrimeNumberDecompositionClient, error)
+	// Streams the primes in [from, to) in increasing order, in batches
+	// A range that is reversed or too large is of type INVALID_ARGUMENT
+	GeneratePrimes(ctx context.Context, in *GeneratePrimesRequest, opts ...grpc.CallOption) (CalculatorService_GeneratePrimesClient, error)
+	// Streams uniform, normal, exponential or Poisson random numbers, reproducible from a seed
+	// or drawn from a cryptographically secure source
+	// Numbers are generated as the client reads them, so a slow reader slows the stream down
+	RandomNumbers(ctx context.Context, in *RandomNumbersRequest, opts ...grpc.CallOption) (CalculatorService_RandomNumbersClient, error)
 	// Client Streaming
 	ComputeAverage(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeAverageClient, error)
+	// Count, sum, mean, variance, standard deviation, min, max, median and percentiles of the streamed numbers
+	// An empty stream or a number that is not finite is of type INVALID_ARGUMENT
+	ComputeStatistics(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeStatisticsClient, error)
+	// Fits a least squares line to the streamed (x, y) points without keeping them
+	// Fewer than 2 points, equal x values and values that are not finite are of type INVALID_ARGUMENT
+	FitLinearRegression(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FitLinearRegressionClient, error)
+	// Counts the streamed numbers into fixed width, log scale or Freedman-Diaconis bins and
+	// summarises them with a t-digest quantile sketch
+	// A missing config, an empty stream and numbers that are not finite are of type INVALID_ARGUMENT
+	BuildHistogram(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_BuildHistogramClient, error)
 	// BiDi Streaming
 	FindMaximum(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FindMaximumClient, error)
+	// Emits the configured aggregate of a sliding window after every number
+	WindowedAggregate(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_WindowedAggregateClient, error)
 	// Errors Handling
 	// This RPC will throw an exception if the sent number is negative
 	// The error being sent is of type INVALID_ARGUMENT
+	// In the complex domain negative numbers have imaginary roots, and in the
+	// integer and rational domains numbers that are not perfect squares are rejected
 	SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error)
+	// Parses and evaluates an arithmetic expression
+	// Supports + - * / % ^, parentheses, unary minus, the constants pi and e
+	// and functions such as sqrt, log, sin, min and max
+	// Parse and evaluation errors are of type INVALID_ARGUMENT and name the column
+	// number_domain selects exact integer or rational arithmetic, or complex numbers
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// Integrates a function of one variable over [lower, upper] with adaptive Gauss-Kronrod quadrature
+	// Malformed expressions and points where the function is undefined are of type INVALID_ARGUMENT
+	Integrate(ctx context.Context, in *IntegrateRequest, opts ...grpc.CallOption) (*IntegrateResponse, error)
+	// Finds a root of a function of one variable within a bracket with Brent's method
+	// A bracket where the function does not change sign is of type INVALID_ARGUMENT
+	FindRoot(ctx context.Context, in *FindRootRequest, opts ...grpc.CallOption) (*FindRootResponse, error)
+	// Simplifies an expression with exact rational arithmetic on its numbers, collecting like
+	// terms and powers and applying identities such as x*1 = x, x^0 = 1 and ln(e) = 1
+	// Returns the result as text and as an expression tree
+	// Malformed expressions and undefined constant parts such as 1/0 are of type INVALID_ARGUMENT
+	Simplify(ctx context.Context, in *SimplifyRequest, opts ...grpc.CallOption) (*SimplifyResponse, error)
+	// Returns the simplified symbolic derivative of an expression with respect to a variable
+	// Functions without a derivative, such as floor or max, applied to the variable are of type INVALID_ARGUMENT
+	Differentiate(ctx context.Context, in *DifferentiateRequest, opts ...grpc.CallOption) (*DifferentiateResponse, error)
+	// Converts a quantity to another unit of the same dimension
+	// Units of different dimensions are of type INVALID_ARGUMENT with an ErrorInfo
+	// detail whose reason is INCOMPATIBLE_DIMENSIONS
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error)
+	// Evaluates an expression of quantities: + and - between quantities of the same dimension,
+	// * and / by plain numbers and / between quantities of the same dimension
+	// Mixing dimensions is of type INVALID_ARGUMENT with reason INCOMPATIBLE_DIMENSIONS
+	EvaluateQuantity(ctx context.Context, in *EvaluateQuantityRequest, opts ...grpc.CallOption) (*EvaluateQuantityResponse, error)
+	// A calculator REPL: variables and functions live as long as the stream
+	// Every statement is answered in order with its result or an error
+	// A stream that uses up its evaluation time is ended with RESOURCE_EXHAUSTED
+	Session(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_SessionClient, error)
+	// Arbitrary-precision variants of Sum and SquareRoot, and exact rational arithmetic
+	// Malformed numbers, division by zero and negative square roots are of type INVALID_ARGUMENT
+	BigSum(ctx context.Context, in *BigSumRequest, opts ...grpc.CallOption) (*BigSumResponse, error)
+	BigArithmetic(ctx context.Context, in *BigArithmeticRequest, opts ...grpc.CallOption) (*BigArithmeticResponse, error)
+	BigSquareRoot(ctx context.Context, in *BigSquareRootRequest, opts ...grpc.CallOption) (*BigSquareRootResponse, error)
+	// Checked add, subtract, multiply, divide and power on int32 or int64 numbers, which never wrap around
+	// A result outside the range of the type is of type OUT_OF_RANGE with an ErrorInfo detail whose reason
+	// is INTEGER_OVERFLOW and whose metadata holds the bounds of the type and the exact result
+	// Operands outside the type, division by zero and negative powers without an integer result are of type INVALID_ARGUMENT
+	IntegerArithmetic(ctx context.Context, in *IntegerArithmeticRequest, opts ...grpc.CallOption) (*IntegerArithmeticResponse, error)
+	// Number theory on integers of any size, given as BigNumber
+	// Numbers that are not integers, moduli below 1 and inverses that do not exist are of type INVALID_ARGUMENT
+	Gcd(ctx context.Context, in *GcdRequest, opts ...grpc.CallOption) (*GcdResponse, error)
+	Lcm(ctx context.Context, in *LcmRequest, opts ...grpc.CallOption) (*LcmResponse, error)
+	ModPow(ctx context.Context, in *ModPowRequest, opts ...grpc.CallOption) (*ModPowResponse, error)
+	ModInverse(ctx context.Context, in *ModInverseRequest, opts ...grpc.CallOption) (*ModInverseResponse, error)
+	IsPrime(ctx context.Context, in *IsPrimeRequest, opts ...grpc.CallOption) (*IsPrimeResponse, error)
+	// Write and read integers of any size in bases 2 to 36, Roman numerals, scientific notation or English words
+	// ParseNumber(FormatNumber(n)) is n; malformed text is of type INVALID_ARGUMENT naming the column
+	// and the character or word at fault
+	FormatNumber(ctx context.Context, in *FormatNumberRequest, opts ...grpc.CallOption) (*FormatNumberResponse, error)
+	ParseNumber(ctx context.Context, in *ParseNumberRequest, opts ...grpc.CallOption) (*ParseNumberResponse, error)
+	// Linear algebra on dense matrices of doubles, using LU decomposition with partial pivoting
+	// Operands whose dimensions do not fit the operation are of type INVALID_ARGUMENT
+	// Inverting a singular matrix or solving a singular system is of type FAILED_PRECONDITION
+	ComputeMatrix(ctx context.Context, in *ComputeMatrixRequest, opts ...grpc.CallOption) (*ComputeMatrixResponse, error)
+	// Same as ComputeMatrix for operands too large for one message
+	UploadMatrix(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_UploadMatrixClient, error)
+	// Runs independent operations on a bounded pool of workers and streams one result per operation,
+	// in input order or in completion order, each with its own status
+	// A failed operation does not end the stream; an empty or oversized batch is of type INVALID_ARGUMENT
+	BatchEvaluate(ctx context.Context, in *BatchEvaluateRequest, opts ...grpc.CallOption) (CalculatorService_BatchEvaluateClient, error)
 }
 
 type calculatorServiceClient struct {
@@ -85,8 +175,72 @@ func (x *calculatorServicePrimeNumberDecompositionClient) Recv() (*PrimeNumberDe
 	return m, nil
 }
 
+func (c *calculatorServiceClient) GeneratePrimes(ctx context.Context, in *GeneratePrimesRequest, opts ...grpc.CallOption) (CalculatorService_GeneratePrimesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[1], "/calculator.CalculatorService/GeneratePrimes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceGeneratePrimesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_GeneratePrimesClient interface {
+	Recv() (*GeneratePrimesResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceGeneratePrimesClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceGeneratePrimesClient) Recv() (*GeneratePrimesResponse, error) {
+	m := new(GeneratePrimesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) RandomNumbers(ctx context.Context, in *RandomNumbersRequest, opts ...grpc.CallOption) (CalculatorService_RandomNumbersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[2], "/calculator.CalculatorService/RandomNumbers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceRandomNumbersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_RandomNumbersClient interface {
+	Recv() (*RandomNumbersResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceRandomNumbersClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceRandomNumbersClient) Recv() (*RandomNumbersResponse, error) {
+	m := new(RandomNumbersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *calculatorServiceClient) ComputeAverage(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeAverageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[1], "/calculator.CalculatorService/ComputeAverage", opts...)
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[3], "/calculator.CalculatorService/ComputeAverage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -119,205 +273,1341 @@ func (x *calculatorServiceComputeAverageClient) CloseAndRecv() (*ComputeAverageR
 	return m, nil
 }
 
-func (c *calculatorServiceClient) FindMaximum(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FindMaximumClient, error) {
-	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[2], "/calculator.CalculatorService/FindMaximum", opts...)
+func (c *calculatorServiceClient) ComputeStatistics(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_ComputeStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[4], "/calculator.CalculatorService/ComputeStatistics", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &calculatorServiceFindMaximumClient{stream}
+	x := &calculatorServiceComputeStatisticsClient{stream}
 	return x, nil
 }
 
-type CalculatorService_FindMaximumClient interface {
-	Send(*FindMaximumRequest) error
-	Recv() (*FindMaximumResponse, error)
+type CalculatorService_ComputeStatisticsClient interface {
+	Send(*ComputeStatisticsRequest) error
+	CloseAndRecv() (*ComputeStatisticsResponse, error)
 	grpc.ClientStream
 }
 
-type calculatorServiceFindMaximumClient struct {
+type calculatorServiceComputeStatisticsClient struct {
 	grpc.ClientStream
 }
 
-func (x *calculatorServiceFindMaximumClient) Send(m *FindMaximumRequest) error {
+func (x *calculatorServiceComputeStatisticsClient) Send(m *ComputeStatisticsRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *calculatorServiceFindMaximumClient) Recv() (*FindMaximumResponse, error) {
-	m := new(FindMaximumResponse)
+func (x *calculatorServiceComputeStatisticsClient) CloseAndRecv() (*ComputeStatisticsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ComputeStatisticsResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *calculatorServiceClient) SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error) {
-	out := new(SquareRootResponse)
-	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/SquareRoot", in, out, opts...)
+func (c *calculatorServiceClient) FitLinearRegression(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FitLinearRegressionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[5], "/calculator.CalculatorService/FitLinearRegression", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &calculatorServiceFitLinearRegressionClient{stream}
+	return x, nil
 }
 
-// CalculatorServiceServer is the server API for CalculatorService service.
-// All implementations should embed UnimplementedCalculatorServiceServer
-// for forward compatibility
-type CalculatorServiceServer interface {
-	// Unary
-	Sum(context.Context, *SumRequest) (*SumResponse, error)
-	// Server Streaming
-	PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error
-	// Client Streaming
-	ComputeAverage(CalculatorService_ComputeAverageServer) error
-	// BiDi Streaming
-	FindMaximum(CalculatorService_FindMaximumServer) error
-	// Errors Handling
-	// This RPC will throw an exception if the sent number is negative
-	// The error being sent is of type INVALID_ARGUMENT
-	SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error)
+type CalculatorService_FitLinearRegressionClient interface {
+	Send(*FitLinearRegressionRequest) error
+	CloseAndRecv() (*FitLinearRegressionResponse, error)
+	grpc.ClientStream
 }
 
-// UnimplementedCalculatorServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedCalculatorServiceServer struct {
+type calculatorServiceFitLinearRegressionClient struct {
+	grpc.ClientStream
 }
 
-func (UnimplementedCalculatorServiceServer) Sum(context.Context, *SumRequest) (*SumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
-}
-func (UnimplementedCalculatorServiceServer) PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error {
-	return status.Errorf(codes.Unimplemented, "method PrimeNumberDecomposition not implemented")
+func (x *calculatorServiceFitLinearRegressionClient) Send(m *FitLinearRegressionRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedCalculatorServiceServer) ComputeAverage(CalculatorService_ComputeAverageServer) error {
-	return status.Errorf(codes.Unimplemented, "method ComputeAverage not implemented")
+
+func (x *calculatorServiceFitLinearRegressionClient) CloseAndRecv() (*FitLinearRegressionResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(FitLinearRegressionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedCalculatorServiceServer) FindMaximum(CalculatorService_FindMaximumServer) error {
-	return status.Errorf(codes.Unimplemented, "method FindMaximum not implemented")
+
+func (c *calculatorServiceClient) BuildHistogram(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_BuildHistogramClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[6], "/calculator.CalculatorService/BuildHistogram", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceBuildHistogramClient{stream}
+	return x, nil
 }
-func (UnimplementedCalculatorServiceServer) SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SquareRoot not implemented")
+
+type CalculatorService_BuildHistogramClient interface {
+	Send(*BuildHistogramRequest) error
+	CloseAndRecv() (*BuildHistogramResponse, error)
+	grpc.ClientStream
 }
 
-// UnsafeCalculatorServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CalculatorServiceServer will
-// result in compilation errors.
-type UnsafeCalculatorServiceServer interface {
-	mustEmbedUnimplementedCalculatorServiceServer()
+type calculatorServiceBuildHistogramClient struct {
+	grpc.ClientStream
 }
 
-func RegisterCalculatorServiceServer(s grpc.ServiceRegistrar, srv CalculatorServiceServer) {
-	s.RegisterService(&CalculatorService_ServiceDesc, srv)
+func (x *calculatorServiceBuildHistogramClient) Send(m *BuildHistogramRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _CalculatorService_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SumRequest)
-	if err := dec(in); err != nil {
+func (x *calculatorServiceBuildHistogramClient) CloseAndRecv() (*BuildHistogramResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CalculatorServiceServer).Sum(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/calculator.CalculatorService/Sum",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CalculatorServiceServer).Sum(ctx, req.(*SumRequest))
+	m := new(BuildHistogramResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _CalculatorService_PrimeNumberDecomposition_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PrimeNumberDecompositionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *calculatorServiceClient) FindMaximum(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_FindMaximumClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[7], "/calculator.CalculatorService/FindMaximum", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(CalculatorServiceServer).PrimeNumberDecomposition(m, &calculatorServicePrimeNumberDecompositionServer{stream})
+	x := &calculatorServiceFindMaximumClient{stream}
+	return x, nil
 }
 
-type CalculatorService_PrimeNumberDecompositionServer interface {
-	Send(*PrimeNumberDecompositionResponse) error
-	grpc.ServerStream
+type CalculatorService_FindMaximumClient interface {
+	Send(*FindMaximumRequest) error
+	Recv() (*FindMaximumResponse, error)
+	grpc.ClientStream
 }
 
-type calculatorServicePrimeNumberDecompositionServer struct {
-	grpc.ServerStream
+type calculatorServiceFindMaximumClient struct {
+	grpc.ClientStream
 }
 
-func (x *calculatorServicePrimeNumberDecompositionServer) Send(m *PrimeNumberDecompositionResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (x *calculatorServiceFindMaximumClient) Send(m *FindMaximumRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _CalculatorService_ComputeAverage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CalculatorServiceServer).ComputeAverage(&calculatorServiceComputeAverageServer{stream})
+func (x *calculatorServiceFindMaximumClient) Recv() (*FindMaximumResponse, error) {
+	m := new(FindMaximumResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type CalculatorService_ComputeAverageServer interface {
-	SendAndClose(*ComputeAverageResponse) error
-	Recv() (*ComputeAverageRequest, error)
-	grpc.ServerStream
+func (c *calculatorServiceClient) WindowedAggregate(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_WindowedAggregateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[8], "/calculator.CalculatorService/WindowedAggregate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceWindowedAggregateClient{stream}
+	return x, nil
 }
 
-type calculatorServiceComputeAverageServer struct {
-	grpc.ServerStream
+type CalculatorService_WindowedAggregateClient interface {
+	Send(*WindowedAggregateRequest) error
+	Recv() (*WindowedAggregateResponse, error)
+	grpc.ClientStream
 }
 
-func (x *calculatorServiceComputeAverageServer) SendAndClose(m *ComputeAverageResponse) error {
-	return x.ServerStream.SendMsg(m)
+type calculatorServiceWindowedAggregateClient struct {
+	grpc.ClientStream
 }
 
-func (x *calculatorServiceComputeAverageServer) Recv() (*ComputeAverageRequest, error) {
-	m := new(ComputeAverageRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *calculatorServiceWindowedAggregateClient) Send(m *WindowedAggregateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorServiceWindowedAggregateClient) Recv() (*WindowedAggregateResponse, error) {
+	m := new(WindowedAggregateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _CalculatorService_FindMaximum_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CalculatorServiceServer).FindMaximum(&calculatorServiceFindMaximumServer{stream})
+func (c *calculatorServiceClient) SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error) {
+	out := new(SquareRootResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/SquareRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type CalculatorService_FindMaximumServer interface {
-	Send(*FindMaximumResponse) error
-	Recv() (*FindMaximumRequest, error)
-	grpc.ServerStream
+func (c *calculatorServiceClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type calculatorServiceFindMaximumServer struct {
-	grpc.ServerStream
+func (c *calculatorServiceClient) Integrate(ctx context.Context, in *IntegrateRequest, opts ...grpc.CallOption) (*IntegrateResponse, error) {
+	out := new(IntegrateResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Integrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServiceFindMaximumServer) Send(m *FindMaximumResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *calculatorServiceClient) FindRoot(ctx context.Context, in *FindRootRequest, opts ...grpc.CallOption) (*FindRootResponse, error) {
+	out := new(FindRootResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/FindRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *calculatorServiceFindMaximumServer) Recv() (*FindMaximumRequest, error) {
-	m := new(FindMaximumRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *calculatorServiceClient) Simplify(ctx context.Context, in *SimplifyRequest, opts ...grpc.CallOption) (*SimplifyResponse, error) {
+	out := new(SimplifyResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Simplify", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _CalculatorService_SquareRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SquareRootRequest)
-	if err := dec(in); err != nil {
+func (c *calculatorServiceClient) Differentiate(ctx context.Context, in *DifferentiateRequest, opts ...grpc.CallOption) (*DifferentiateResponse, error) {
+	out := new(DifferentiateResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Differentiate", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CalculatorServiceServer).SquareRoot(ctx, in)
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*ConvertResponse, error) {
+	out := new(ConvertResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Convert", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/calculator.CalculatorService/SquareRoot",
+	return out, nil
+}
+
+func (c *calculatorServiceClient) EvaluateQuantity(ctx context.Context, in *EvaluateQuantityRequest, opts ...grpc.CallOption) (*EvaluateQuantityResponse, error) {
+	out := new(EvaluateQuantityResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/EvaluateQuantity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CalculatorServiceServer).SquareRoot(ctx, req.(*SquareRootRequest))
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Session(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_SessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[9], "/calculator.CalculatorService/Session", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	x := &calculatorServiceSessionClient{stream}
+	return x, nil
 }
 
-// CalculatorService_ServiceDesc is the grpc.ServiceDesc for CalculatorService service.
+type CalculatorService_SessionClient interface {
+	Send(*SessionRequest) error
+	Recv() (*SessionResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceSessionClient) Send(m *SessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorServiceSessionClient) Recv() (*SessionResponse, error) {
+	m := new(SessionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) BigSum(ctx context.Context, in *BigSumRequest, opts ...grpc.CallOption) (*BigSumResponse, error) {
+	out := new(BigSumResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigSum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) BigArithmetic(ctx context.Context, in *BigArithmeticRequest, opts ...grpc.CallOption) (*BigArithmeticResponse, error) {
+	out := new(BigArithmeticResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigArithmetic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) BigSquareRoot(ctx context.Context, in *BigSquareRootRequest, opts ...grpc.CallOption) (*BigSquareRootResponse, error) {
+	out := new(BigSquareRootResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/BigSquareRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) IntegerArithmetic(ctx context.Context, in *IntegerArithmeticRequest, opts ...grpc.CallOption) (*IntegerArithmeticResponse, error) {
+	out := new(IntegerArithmeticResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/IntegerArithmetic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Gcd(ctx context.Context, in *GcdRequest, opts ...grpc.CallOption) (*GcdResponse, error) {
+	out := new(GcdResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Gcd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) Lcm(ctx context.Context, in *LcmRequest, opts ...grpc.CallOption) (*LcmResponse, error) {
+	out := new(LcmResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/Lcm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ModPow(ctx context.Context, in *ModPowRequest, opts ...grpc.CallOption) (*ModPowResponse, error) {
+	out := new(ModPowResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ModPow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ModInverse(ctx context.Context, in *ModInverseRequest, opts ...grpc.CallOption) (*ModInverseResponse, error) {
+	out := new(ModInverseResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ModInverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) IsPrime(ctx context.Context, in *IsPrimeRequest, opts ...grpc.CallOption) (*IsPrimeResponse, error) {
+	out := new(IsPrimeResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/IsPrime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) FormatNumber(ctx context.Context, in *FormatNumberRequest, opts ...grpc.CallOption) (*FormatNumberResponse, error) {
+	out := new(FormatNumberResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/FormatNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ParseNumber(ctx context.Context, in *ParseNumberRequest, opts ...grpc.CallOption) (*ParseNumberResponse, error) {
+	out := new(ParseNumberResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ParseNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) ComputeMatrix(ctx context.Context, in *ComputeMatrixRequest, opts ...grpc.CallOption) (*ComputeMatrixResponse, error) {
+	out := new(ComputeMatrixResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorService/ComputeMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) UploadMatrix(ctx context.Context, opts ...grpc.CallOption) (CalculatorService_UploadMatrixClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[10], "/calculator.CalculatorService/UploadMatrix", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceUploadMatrixClient{stream}
+	return x, nil
+}
+
+type CalculatorService_UploadMatrixClient interface {
+	Send(*UploadMatrixRequest) error
+	CloseAndRecv() (*ComputeMatrixResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceUploadMatrixClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceUploadMatrixClient) Send(m *UploadMatrixRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorServiceUploadMatrixClient) CloseAndRecv() (*ComputeMatrixResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ComputeMatrixResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorServiceClient) BatchEvaluate(ctx context.Context, in *BatchEvaluateRequest, opts ...grpc.CallOption) (CalculatorService_BatchEvaluateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[11], "/calculator.CalculatorService/BatchEvaluate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorServiceBatchEvaluateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CalculatorService_BatchEvaluateClient interface {
+	Recv() (*BatchEvaluateResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorServiceBatchEvaluateClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorServiceBatchEvaluateClient) Recv() (*BatchEvaluateResponse, error) {
+	m := new(BatchEvaluateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CalculatorServiceServer is the server API for CalculatorService service.
+// All implementations should embed UnimplementedCalculatorServiceServer
+// for forward compatibility
+type CalculatorServiceServer interface {
+	// Unary
+	// Sum returns OUT_OF_RANGE with an INTEGER_OVERFLOW ErrorInfo, like
+	// IntegerArithmetic, when the result leaves the int32 range.
+	// This changes its behaviour: it used to wrap around.
+	Sum(context.Context, *SumRequest) (*SumResponse, error)
+	// Server Streaming
+	// Streams every prime factor as soon as it is found, not necessarily in
+	// increasing order, see group_factors
+	PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error
+	// Streams the primes in [from, to) in increasing order, in batches
+	// A range that is reversed or too large is of type INVALID_ARGUMENT
+	GeneratePrimes(*GeneratePrimesRequest, CalculatorService_GeneratePrimesServer) error
+	// Streams uniform, normal, exponential or Poisson random numbers, reproducible from a seed
+	// or drawn from a cryptographically secure source
+	// Numbers are generated as the client reads them, so a slow reader slows the stream down
+	RandomNumbers(*RandomNumbersRequest, CalculatorService_RandomNumbersServer) error
+	// Client Streaming
+	ComputeAverage(CalculatorService_ComputeAverageServer) error
+	// Count, sum, mean, variance, standard deviation, min, max, median and percentiles of the streamed numbers
+	// An empty stream or a number that is not finite is of type INVALID_ARGUMENT
+	ComputeStatistics(CalculatorService_ComputeStatisticsServer) error
+	// Fits a least squares line to the streamed (x, y) points without keeping them
+	// Fewer than 2 points, equal x values and values that are not finite are of type INVALID_ARGUMENT
+	FitLinearRegression(CalculatorService_FitLinearRegressionServer) error
+	// Counts the streamed numbers into fixed width, log scale or Freedman-Diaconis bins and
+	// summarises them with a t-digest quantile sketch
+	// A missing config, an empty stream and numbers that are not finite are of type INVALID_ARGUMENT
+	BuildHistogram(CalculatorService_BuildHistogramServer) error
+	// BiDi Streaming
+	FindMaximum(CalculatorService_FindMaximumServer) error
+	// Emits the configured aggregate of a sliding window after every number
+	WindowedAggregate(CalculatorService_WindowedAggregateServer) error
+	// Errors Handling
+	// This RPC will throw an exception if the sent number is negative
+	// The error being sent is of type INVALID_ARGUMENT
+	// In the complex domain negative numbers have imaginary roots, and in the
+	// integer and rational domains numbers that are not perfect squares are rejected
+	SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error)
+	// Parses and evaluates an arithmetic expression
+	// Supports + - * / % ^, parentheses, unary minus, the constants pi and e
+	// and functions such as sqrt, log, sin, min and max
+	// Parse and evaluation errors are of type INVALID_ARGUMENT and name the column
+	// number_domain selects exact integer or rational arithmetic, or complex numbers
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// Integrates a function of one variable over [lower, upper] with adaptive Gauss-Kronrod quadrature
+	// Malformed expressions and points where the function is undefined are of type INVALID_ARGUMENT
+	Integrate(context.Context, *IntegrateRequest) (*IntegrateResponse, error)
+	// Finds a root of a function of one variable within a bracket with Brent's method
+	// A bracket where the function does not change sign is of type INVALID_ARGUMENT
+	FindRoot(context.Context, *FindRootRequest) (*FindRootResponse, error)
+	// Simplifies an expression with exact rational arithmetic on its numbers, collecting like
+	// terms and powers and applying identities such as x*1 = x, x^0 = 1 and ln(e) = 1
+	// Returns the result as text and as an expression tree
+	// Malformed expressions and undefined constant parts such as 1/0 are of type INVALID_ARGUMENT
+	Simplify(context.Context, *SimplifyRequest) (*SimplifyResponse, error)
+	// Returns the simplified symbolic derivative of an expression with respect to a variable
+	// Functions without a derivative, such as floor or max, applied to the variable are of type INVALID_ARGUMENT
+	Differentiate(context.Context, *DifferentiateRequest) (*DifferentiateResponse, error)
+	// Converts a quantity to another unit of the same dimension
+	// Units of different dimensions are of type INVALID_ARGUMENT with an ErrorInfo
+	// detail whose reason is INCOMPATIBLE_DIMENSIONS
+	Convert(context.Context, *ConvertRequest) (*ConvertResponse, error)
+	// Evaluates an expression of quantities: + and - between quantities of the same dimension,
+	// * and / by plain numbers and / between quantities of the same dimension
+	// Mixing dimensions is of type INVALID_ARGUMENT with reason INCOMPATIBLE_DIMENSIONS
+	EvaluateQuantity(context.Context, *EvaluateQuantityRequest) (*EvaluateQuantityResponse, error)
+	// A calculator REPL: variables and functions live as long as the stream
+	// Every statement is answered in order with its result or an error
+	// A stream that uses up its evaluation time is ended with RESOURCE_EXHAUSTED
+	Session(CalculatorService_SessionServer) error
+	// Arbitrary-precision variants of Sum and SquareRoot, and exact rational arithmetic
+	// Malformed numbers, division by zero and negative square roots are of type INVALID_ARGUMENT
+	BigSum(context.Context, *BigSumRequest) (*BigSumResponse, error)
+	BigArithmetic(context.Context, *BigArithmeticRequest) (*BigArithmeticResponse, error)
+	BigSquareRoot(context.Context, *BigSquareRootRequest) (*BigSquareRootResponse, error)
+	// Checked add, subtract, multiply, divide and power on int32 or int64 numbers, which never wrap around
+	// A result outside the range of the type is of type OUT_OF_RANGE with an ErrorInfo detail whose reason
+	// is INTEGER_OVERFLOW and whose metadata holds the bounds of the type and the exact result
+	// Operands outside the type, division by zero and negative powers without an integer result are of type INVALID_ARGUMENT
+	IntegerArithmetic(context.Context, *IntegerArithmeticRequest) (*IntegerArithmeticResponse, error)
+	// Number theory on integers of any size, given as BigNumber
+	// Numbers that are not integers, moduli below 1 and inverses that do not exist are of type INVALID_ARGUMENT
+	Gcd(context.Context, *GcdRequest) (*GcdResponse, error)
+	Lcm(context.Context, *LcmRequest) (*LcmResponse, error)
+	ModPow(context.Context, *ModPowRequest) (*ModPowResponse, error)
+	ModInverse(context.Context, *ModInverseRequest) (*ModInverseResponse, error)
+	IsPrime(context.Context, *IsPrimeRequest) (*IsPrimeResponse, error)
+	// Write and read integers of any size in bases 2 to 36, Roman numerals, scientific notation or English words
+	// ParseNumber(FormatNumber(n)) is n; malformed text is of type INVALID_ARGUMENT naming the column
+	// and the character or word at fault
+	FormatNumber(context.Context, *FormatNumberRequest) (*FormatNumberResponse, error)
+	ParseNumber(context.Context, *ParseNumberRequest) (*ParseNumberResponse, error)
+	// Linear algebra on dense matrices of doubles, using LU decomposition with partial pivoting
+	// Operands whose dimensions do not fit the operation are of type INVALID_ARGUMENT
+	// Inverting a singular matrix or solving a singular system is of type FAILED_PRECONDITION
+	ComputeMatrix(context.Context, *ComputeMatrixRequest) (*ComputeMatrixResponse, error)
+	// Same as ComputeMatrix for operands too large for one message
+	UploadMatrix(CalculatorService_UploadMatrixServer) error
+	// Runs independent operations on a bounded pool of workers and streams one result per operation,
+	// in input order or in completion order, each with its own status
+	// A failed operation does not end the stream; an empty or oversized batch is of type INVALID_ARGUMENT
+	BatchEvaluate(*BatchEvaluateRequest, CalculatorService_BatchEvaluateServer) error
+}
+
+// UnimplementedCalculatorServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCalculatorServiceServer struct {
+}
+
+func (UnimplementedCalculatorServiceServer) Sum(context.Context, *SumRequest) (*SumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sum not implemented")
+}
+func (UnimplementedCalculatorServiceServer) PrimeNumberDecomposition(*PrimeNumberDecompositionRequest, CalculatorService_PrimeNumberDecompositionServer) error {
+	return status.Errorf(codes.Unimplemented, "method PrimeNumberDecomposition not implemented")
+}
+func (UnimplementedCalculatorServiceServer) GeneratePrimes(*GeneratePrimesRequest, CalculatorService_GeneratePrimesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GeneratePrimes not implemented")
+}
+func (UnimplementedCalculatorServiceServer) RandomNumbers(*RandomNumbersRequest, CalculatorService_RandomNumbersServer) error {
+	return status.Errorf(codes.Unimplemented, "method RandomNumbers not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComputeAverage(CalculatorService_ComputeAverageServer) error {
+	return status.Errorf(codes.Unimplemented, "method ComputeAverage not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComputeStatistics(CalculatorService_ComputeStatisticsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ComputeStatistics not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FitLinearRegression(CalculatorService_FitLinearRegressionServer) error {
+	return status.Errorf(codes.Unimplemented, "method FitLinearRegression not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BuildHistogram(CalculatorService_BuildHistogramServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildHistogram not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FindMaximum(CalculatorService_FindMaximumServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindMaximum not implemented")
+}
+func (UnimplementedCalculatorServiceServer) WindowedAggregate(CalculatorService_WindowedAggregateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WindowedAggregate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SquareRoot not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Integrate(context.Context, *IntegrateRequest) (*IntegrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FindRoot(context.Context, *FindRootRequest) (*FindRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRoot not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Simplify(context.Context, *SimplifyRequest) (*SimplifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simplify not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Differentiate(context.Context, *DifferentiateRequest) (*DifferentiateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Differentiate not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Convert(context.Context, *ConvertRequest) (*ConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedCalculatorServiceServer) EvaluateQuantity(context.Context, *EvaluateQuantityRequest) (*EvaluateQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateQuantity not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Session(CalculatorService_SessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigSum(context.Context, *BigSumRequest) (*BigSumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigSum not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigArithmetic(context.Context, *BigArithmeticRequest) (*BigArithmeticResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigArithmetic not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BigSquareRoot(context.Context, *BigSquareRootRequest) (*BigSquareRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BigSquareRoot not implemented")
+}
+func (UnimplementedCalculatorServiceServer) IntegerArithmetic(context.Context, *IntegerArithmeticRequest) (*IntegerArithmeticResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegerArithmetic not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Gcd(context.Context, *GcdRequest) (*GcdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Gcd not implemented")
+}
+func (UnimplementedCalculatorServiceServer) Lcm(context.Context, *LcmRequest) (*LcmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lcm not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ModPow(context.Context, *ModPowRequest) (*ModPowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModPow not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ModInverse(context.Context, *ModInverseRequest) (*ModInverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModInverse not implemented")
+}
+func (UnimplementedCalculatorServiceServer) IsPrime(context.Context, *IsPrimeRequest) (*IsPrimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPrime not implemented")
+}
+func (UnimplementedCalculatorServiceServer) FormatNumber(context.Context, *FormatNumberRequest) (*FormatNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormatNumber not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ParseNumber(context.Context, *ParseNumberRequest) (*ParseNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseNumber not implemented")
+}
+func (UnimplementedCalculatorServiceServer) ComputeMatrix(context.Context, *ComputeMatrixRequest) (*ComputeMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeMatrix not implemented")
+}
+func (UnimplementedCalculatorServiceServer) UploadMatrix(CalculatorService_UploadMatrixServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMatrix not implemented")
+}
+func (UnimplementedCalculatorServiceServer) BatchEvaluate(*BatchEvaluateRequest, CalculatorService_BatchEvaluateServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchEvaluate not implemented")
+}
+
+// UnsafeCalculatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalculatorServiceServer will
+// result in compilation errors.
+type UnsafeCalculatorServiceServer interface {
+	mustEmbedUnimplementedCalculatorServiceServer()
+}
+
+func RegisterCalculatorServiceServer(s grpc.ServiceRegistrar, srv CalculatorServiceServer) {
+	s.RegisterService(&CalculatorService_ServiceDesc, srv)
+}
+
+func _CalculatorService_Sum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Sum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Sum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Sum(ctx, req.(*SumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_PrimeNumberDecomposition_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrimeNumberDecompositionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).PrimeNumberDecomposition(m, &calculatorServicePrimeNumberDecompositionServer{stream})
+}
+
+type CalculatorService_PrimeNumberDecompositionServer interface {
+	Send(*PrimeNumberDecompositionResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServicePrimeNumberDecompositionServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServicePrimeNumberDecompositionServer) Send(m *PrimeNumberDecompositionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_GeneratePrimes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GeneratePrimesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).GeneratePrimes(m, &calculatorServiceGeneratePrimesServer{stream})
+}
+
+type CalculatorService_GeneratePrimesServer interface {
+	Send(*GeneratePrimesResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServiceGeneratePrimesServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceGeneratePrimesServer) Send(m *GeneratePrimesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_RandomNumbers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RandomNumbersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).RandomNumbers(m, &calculatorServiceRandomNumbersServer{stream})
+}
+
+type CalculatorService_RandomNumbersServer interface {
+	Send(*RandomNumbersResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServiceRandomNumbersServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceRandomNumbersServer) Send(m *RandomNumbersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CalculatorService_ComputeAverage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).ComputeAverage(&calculatorServiceComputeAverageServer{stream})
+}
+
+type CalculatorService_ComputeAverageServer interface {
+	SendAndClose(*ComputeAverageResponse) error
+	Recv() (*ComputeAverageRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceComputeAverageServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceComputeAverageServer) SendAndClose(m *ComputeAverageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceComputeAverageServer) Recv() (*ComputeAverageRequest, error) {
+	m := new(ComputeAverageRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_ComputeStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).ComputeStatistics(&calculatorServiceComputeStatisticsServer{stream})
+}
+
+type CalculatorService_ComputeStatisticsServer interface {
+	SendAndClose(*ComputeStatisticsResponse) error
+	Recv() (*ComputeStatisticsRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceComputeStatisticsServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceComputeStatisticsServer) SendAndClose(m *ComputeStatisticsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceComputeStatisticsServer) Recv() (*ComputeStatisticsRequest, error) {
+	m := new(ComputeStatisticsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_FitLinearRegression_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).FitLinearRegression(&calculatorServiceFitLinearRegressionServer{stream})
+}
+
+type CalculatorService_FitLinearRegressionServer interface {
+	SendAndClose(*FitLinearRegressionResponse) error
+	Recv() (*FitLinearRegressionRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceFitLinearRegressionServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceFitLinearRegressionServer) SendAndClose(m *FitLinearRegressionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceFitLinearRegressionServer) Recv() (*FitLinearRegressionRequest, error) {
+	m := new(FitLinearRegressionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_BuildHistogram_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).BuildHistogram(&calculatorServiceBuildHistogramServer{stream})
+}
+
+type CalculatorService_BuildHistogramServer interface {
+	SendAndClose(*BuildHistogramResponse) error
+	Recv() (*BuildHistogramRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceBuildHistogramServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceBuildHistogramServer) SendAndClose(m *BuildHistogramResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceBuildHistogramServer) Recv() (*BuildHistogramRequest, error) {
+	m := new(BuildHistogramRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_FindMaximum_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).FindMaximum(&calculatorServiceFindMaximumServer{stream})
+}
+
+type CalculatorService_FindMaximumServer interface {
+	Send(*FindMaximumResponse) error
+	Recv() (*FindMaximumRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceFindMaximumServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceFindMaximumServer) Send(m *FindMaximumResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceFindMaximumServer) Recv() (*FindMaximumRequest, error) {
+	m := new(FindMaximumRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_WindowedAggregate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).WindowedAggregate(&calculatorServiceWindowedAggregateServer{stream})
+}
+
+type CalculatorService_WindowedAggregateServer interface {
+	Send(*WindowedAggregateResponse) error
+	Recv() (*WindowedAggregateRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceWindowedAggregateServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceWindowedAggregateServer) Send(m *WindowedAggregateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceWindowedAggregateServer) Recv() (*WindowedAggregateRequest, error) {
+	m := new(WindowedAggregateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_SquareRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquareRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).SquareRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/SquareRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).SquareRoot(ctx, req.(*SquareRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Integrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Integrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Integrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Integrate(ctx, req.(*IntegrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_FindRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).FindRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/FindRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).FindRoot(ctx, req.(*FindRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Simplify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimplifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Simplify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Simplify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Simplify(ctx, req.(*SimplifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Differentiate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DifferentiateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Differentiate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Differentiate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Differentiate(ctx, req.(*DifferentiateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Convert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_EvaluateQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).EvaluateQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/EvaluateQuantity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).EvaluateQuantity(ctx, req.(*EvaluateQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Session_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).Session(&calculatorServiceSessionServer{stream})
+}
+
+type CalculatorService_SessionServer interface {
+	Send(*SessionResponse) error
+	Recv() (*SessionRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceSessionServer) Send(m *SessionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceSessionServer) Recv() (*SessionRequest, error) {
+	m := new(SessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_BigSum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigSumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigSum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigSum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigSum(ctx, req.(*BigSumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigArithmetic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigArithmeticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigArithmetic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigArithmetic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigArithmetic(ctx, req.(*BigArithmeticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_BigSquareRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigSquareRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).BigSquareRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/BigSquareRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).BigSquareRoot(ctx, req.(*BigSquareRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_IntegerArithmetic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerArithmeticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).IntegerArithmetic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/IntegerArithmetic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).IntegerArithmetic(ctx, req.(*IntegerArithmeticRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Gcd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GcdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Gcd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Gcd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Gcd(ctx, req.(*GcdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_Lcm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LcmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).Lcm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/Lcm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).Lcm(ctx, req.(*LcmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ModPow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModPowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ModPow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ModPow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ModPow(ctx, req.(*ModPowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ModInverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModInverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ModInverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ModInverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ModInverse(ctx, req.(*ModInverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_IsPrime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsPrimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).IsPrime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/IsPrime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).IsPrime(ctx, req.(*IsPrimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_FormatNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).FormatNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/FormatNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).FormatNumber(ctx, req.(*FormatNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ParseNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ParseNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ParseNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ParseNumber(ctx, req.(*ParseNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_ComputeMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).ComputeMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorService/ComputeMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).ComputeMatrix(ctx, req.(*ComputeMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_UploadMatrix_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).UploadMatrix(&calculatorServiceUploadMatrixServer{stream})
+}
+
+type CalculatorService_UploadMatrixServer interface {
+	SendAndClose(*ComputeMatrixResponse) error
+	Recv() (*UploadMatrixRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorServiceUploadMatrixServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceUploadMatrixServer) SendAndClose(m *ComputeMatrixResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorServiceUploadMatrixServer) Recv() (*UploadMatrixRequest, error) {
+	m := new(UploadMatrixRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CalculatorService_BatchEvaluate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchEvaluateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServiceServer).BatchEvaluate(m, &calculatorServiceBatchEvaluateServer{stream})
+}
+
+type CalculatorService_BatchEvaluateServer interface {
+	Send(*BatchEvaluateResponse) error
+	grpc.ServerStream
+}
+
+type calculatorServiceBatchEvaluateServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorServiceBatchEvaluateServer) Send(m *BatchEvaluateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// CalculatorService_ServiceDesc is the grpc.ServiceDesc for CalculatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var CalculatorService_ServiceDesc = grpc.ServiceDesc{
@@ -332,6 +1622,82 @@ var CalculatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SquareRoot",
 			Handler:    _CalculatorService_SquareRoot_Handler,
 		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _CalculatorService_Evaluate_Handler,
+		},
+		{
+			MethodName: "Integrate",
+			Handler:    _CalculatorService_Integrate_Handler,
+		},
+		{
+			MethodName: "FindRoot",
+			Handler:    _CalculatorService_FindRoot_Handler,
+		},
+		{
+			MethodName: "Simplify",
+			Handler:    _CalculatorService_Simplify_Handler,
+		},
+		{
+			MethodName: "Differentiate",
+			Handler:    _CalculatorService_Differentiate_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _CalculatorService_Convert_Handler,
+		},
+		{
+			MethodName: "EvaluateQuantity",
+			Handler:    _CalculatorService_EvaluateQuantity_Handler,
+		},
+		{
+			MethodName: "BigSum",
+			Handler:    _CalculatorService_BigSum_Handler,
+		},
+		{
+			MethodName: "BigArithmetic",
+			Handler:    _CalculatorService_BigArithmetic_Handler,
+		},
+		{
+			MethodName: "BigSquareRoot",
+			Handler:    _CalculatorService_BigSquareRoot_Handler,
+		},
+		{
+			MethodName: "IntegerArithmetic",
+			Handler:    _CalculatorService_IntegerArithmetic_Handler,
+		},
+		{
+			MethodName: "Gcd",
+			Handler:    _CalculatorService_Gcd_Handler,
+		},
+		{
+			MethodName: "Lcm",
+			Handler:    _CalculatorService_Lcm_Handler,
+		},
+		{
+			MethodName: "ModPow",
+			Handler:    _CalculatorService_ModPow_Handler,
+		},
+		{
+			MethodName: "ModInverse",
+			Handler:    _CalculatorService_ModInverse_Handler,
+		},
+		{
+			MethodName: "IsPrime",
+			Handler:    _CalculatorService_IsPrime_Handler,
+		},
+		{
+			MethodName: "FormatNumber",
+			Handler:    _CalculatorService_FormatNumber_Handler,
+		},
+		{
+			MethodName: "ParseNumber",
+			Handler:    _CalculatorService_ParseNumber_Handler,
+		},
+		{
+			MethodName: "ComputeMatrix",
+			Handler:    _CalculatorService_ComputeMatrix_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -339,17 +1705,188 @@ var CalculatorService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CalculatorService_PrimeNumberDecomposition_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GeneratePrimes",
+			Handler:       _CalculatorService_GeneratePrimes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RandomNumbers",
+			Handler:       _CalculatorService_RandomNumbers_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ComputeAverage",
 			Handler:       _CalculatorService_ComputeAverage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ComputeStatistics",
+			Handler:       _CalculatorService_ComputeStatistics_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "FitLinearRegression",
+			Handler:       _CalculatorService_FitLinearRegression_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BuildHistogram",
+			Handler:       _CalculatorService_BuildHistogram_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "FindMaximum",
 			Handler:       _CalculatorService_FindMaximum_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WindowedAggregate",
+			Handler:       _CalculatorService_WindowedAggregate_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Session",
+			Handler:       _CalculatorService_Session_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadMatrix",
+			Handler:       _CalculatorService_UploadMatrix_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchEvaluate",
+			Handler:       _CalculatorService_BatchEvaluate_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "calculator/calculatorpb/calculator.proto",
+}
+
+// CalculatorAdminServiceClient is the client API for CalculatorAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalculatorAdminServiceClient interface {
+	// Statistics of the cache of results shared by the CalculatorService RPCs
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*CacheStats, error)
+	// Drops every cached result; computations in progress are not cached when they finish
+	FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error)
+}
+
+type calculatorAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalculatorAdminServiceClient(cc grpc.ClientConnInterface) CalculatorAdminServiceClient {
+	return &calculatorAdminServiceClient{cc}
+}
+
+func (c *calculatorAdminServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*CacheStats, error) {
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorAdminService/GetCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorAdminServiceClient) FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error) {
+	out := new(FlushCacheResponse)
+	err := c.cc.Invoke(ctx, "/calculator.CalculatorAdminService/FlushCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalculatorAdminServiceServer is the server API for CalculatorAdminService service.
+// All implementations should embed UnimplementedCalculatorAdminServiceServer
+// for forward compatibility
+type CalculatorAdminServiceServer interface {
+	// Statistics of the cache of results shared by the CalculatorService RPCs
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*CacheStats, error)
+	// Drops every cached result; computations in progress are not cached when they finish
+	FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error)
+}
+
+// UnimplementedCalculatorAdminServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCalculatorAdminServiceServer struct {
+}
+
+func (UnimplementedCalculatorAdminServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*CacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedCalculatorAdminServiceServer) FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCache not implemented")
+}
+
+// UnsafeCalculatorAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalculatorAdminServiceServer will
+// result in compilation errors.
+type UnsafeCalculatorAdminServiceServer interface {
+	mustEmbedUnimplementedCalculatorAdminServiceServer()
+}
+
+func RegisterCalculatorAdminServiceServer(s grpc.ServiceRegistrar, srv CalculatorAdminServiceServer) {
+	s.RegisterService(&CalculatorAdminService_ServiceDesc, srv)
+}
+
+func _CalculatorAdminService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorAdminServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorAdminService/GetCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorAdminServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorAdminService_FlushCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorAdminServiceServer).FlushCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calculator.CalculatorAdminService/FlushCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorAdminServiceServer).FlushCache(ctx, req.(*FlushCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalculatorAdminService_ServiceDesc is the grpc.ServiceDesc for CalculatorAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalculatorAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.CalculatorAdminService",
+	HandlerType: (*CalculatorAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _CalculatorAdminService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "FlushCache",
+			Handler:    _CalculatorAdminService_FlushCache_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "calculator/calculatorpb/calculator.proto",
 }